@@ -0,0 +1,317 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+type (
+	config struct {
+		top         int
+		slow        int
+		n1Threshold int
+		n1Gap       time.Duration
+	}
+
+	// line contains fields of sink.Logger line that are used by reports
+	line struct {
+		Time       time.Time `json:"time"`
+		Event      string    `json:"event"`
+		DurationMS float64   `json:"duration_ms"`
+		TxID       uint64    `json:"tx_id"`
+		Query      string    `json:"query"`
+		Err        string    `json:"err"`
+		ErrClass   string    `json:"err_class"`
+		// Fingerprint, Count and Callers are fields of n_plus_one event
+		Fingerprint string   `json:"fingerprint"`
+		Count       int      `json:"count"`
+		Callers     []string `json:"callers"`
+	}
+
+	// call is a single execution of a query
+	call struct {
+		time     time.Time
+		duration time.Duration
+		query    string
+		txID     uint64
+		err      string
+	}
+
+	// fingerprintStats aggregates calls of queries with the same fingerprint
+	fingerprintStats struct {
+		fingerprint string
+		durations   []time.Duration
+		total       time.Duration
+		errors      int
+	}
+
+	errorStats struct {
+		err          string
+		count        int
+		fingerprints map[string]int
+	}
+
+	// tx is a transaction which end is not read yet
+	tx struct {
+		begin time.Time
+	}
+
+	txStats struct {
+		durations []time.Duration
+		commits   int
+		rollbacks int
+		failed    int
+	}
+
+	// run is a sequence of calls with the same fingerprint in the same transaction
+	run struct {
+		fingerprint string
+		txID        uint64
+		start       time.Time
+		last        time.Time
+		count       int
+		total       time.Duration
+	}
+
+	analyzer struct {
+		cfg config
+
+		lines     int
+		malformed int
+		calls     int
+
+		fingerprints map[string]*fingerprintStats
+		errors       map[string]*errorStats
+		slowest      []call
+		txs          map[uint64]tx
+		txStats      txStats
+		runs         map[runKey]*run
+		bursts       []run
+		nPlusOnes    []nPlusOne
+	}
+
+	runKey struct {
+		txID        uint64
+		fingerprint string
+	}
+
+	// nPlusOne is N+1 pattern detected by budget of logsql connector
+	nPlusOne struct {
+		time        time.Time
+		fingerprint string
+		count       int
+		txID        uint64
+		callers     []string
+	}
+)
+
+var queryEvents = []string{"exec", "query", "exec_prepared_statement", "query_prepared_statement"}
+
+// validate returns error if flags are out of range
+func (c config) validate() error {
+	switch {
+	case c.top < 0:
+		return fmt.Errorf("-top must not be negative, got %d", c.top)
+	case c.slow < 0:
+		return fmt.Errorf("-slow must not be negative, got %d", c.slow)
+	case c.n1Threshold < 1:
+		return fmt.Errorf("-n1 must be positive, got %d", c.n1Threshold)
+	case c.n1Gap < 0:
+		return fmt.Errorf("-n1-gap must not be negative, got %s", c.n1Gap)
+	default:
+		return nil
+	}
+}
+
+func newAnalyzer(cfg config) *analyzer {
+	return &analyzer{
+		cfg:          cfg,
+		fingerprints: make(map[string]*fingerprintStats),
+		errors:       make(map[string]*errorStats),
+		txs:          make(map[uint64]tx),
+		runs:         make(map[runKey]*run),
+	}
+}
+
+// read consumes lines of r, malformed lines are counted and skipped
+func (a *analyzer) read(r io.Reader) error {
+	br := bufio.NewReader(r)
+	for {
+		b, err := br.ReadBytes('\n')
+		if len(b) > 0 {
+			a.lines++
+
+			var l line
+			if json.Unmarshal(b, &l) != nil || l.Event == "" {
+				a.malformed++
+			} else {
+				a.add(l)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (a *analyzer) add(l line) {
+	dt := time.Duration(l.DurationMS * float64(time.Millisecond))
+
+	switch {
+	case slices.Contains(queryEvents, l.Event):
+		// Calls that fell back to another way after driver.ErrSkip are logged again by that way
+		if l.ErrClass == logsql.ErrClassSkip.String() || l.Err == driver.ErrSkip.Error() {
+			return
+		}
+		a.addCall(call{
+			time:     l.Time,
+			duration: dt,
+			query:    l.Query,
+			txID:     l.TxID,
+			err:      l.Err,
+		})
+	case l.Event == "n_plus_one":
+		a.nPlusOnes = append(a.nPlusOnes, nPlusOne{
+			time:        l.Time,
+			fingerprint: l.Fingerprint,
+			count:       l.Count,
+			txID:        l.TxID,
+			callers:     l.Callers,
+		})
+	case l.Event == "tx_begin" && l.Err == "" && l.TxID != 0:
+		// Time of the line is the end of the call, so transaction started dt earlier
+		a.txs[l.TxID] = tx{begin: l.Time.Add(-dt)}
+	case l.Event == "tx_commit" || l.Event == "tx_rollback":
+		t, ok := a.txs[l.TxID]
+		if !ok {
+			return
+		}
+		delete(a.txs, l.TxID)
+
+		a.txStats.durations = append(a.txStats.durations, l.Time.Sub(t.begin))
+		switch {
+		case l.Err != "":
+			a.txStats.failed++
+		case l.Event == "tx_commit":
+			a.txStats.commits++
+		default:
+			a.txStats.rollbacks++
+		}
+	}
+}
+
+func (a *analyzer) addCall(c call) {
+	a.calls++
+
+	fingerprint := logsql.Fingerprint(c.query)
+
+	fs, ok := a.fingerprints[fingerprint]
+	if !ok {
+		fs = &fingerprintStats{fingerprint: fingerprint}
+		a.fingerprints[fingerprint] = fs
+	}
+	fs.durations = append(fs.durations, c.duration)
+	fs.total += c.duration
+
+	if c.err != "" {
+		fs.errors++
+
+		es, ok := a.errors[c.err]
+		if !ok {
+			es = &errorStats{err: c.err, fingerprints: make(map[string]int)}
+			a.errors[c.err] = es
+		}
+		es.count++
+		es.fingerprints[fingerprint]++
+	}
+
+	a.addSlow(c)
+
+	// Lines do not identify connections, so queries outside transactions can not be told apart from queries of
+	// concurrent requests
+	if c.txID != 0 && slices.Equal(logsql.StatementKeywords(c.query), []string{"select"}) {
+		a.addRun(c, fingerprint)
+	}
+}
+
+// addSlow keeps cfg.slow slowest calls sorted by duration descending
+func (a *analyzer) addSlow(c call) {
+	if a.cfg.slow <= 0 {
+		return
+	}
+	if len(a.slowest) == a.cfg.slow && c.duration <= a.slowest[len(a.slowest)-1].duration {
+		return
+	}
+
+	i, _ := slices.BinarySearchFunc(a.slowest, c, func(e, t call) int {
+		return cmp.Compare(t.duration, e.duration)
+	})
+	a.slowest = slices.Insert(a.slowest, i, c)
+	if len(a.slowest) > a.cfg.slow {
+		a.slowest = a.slowest[:a.cfg.slow]
+	}
+}
+
+// addRun extends run of fingerprint in transaction of the call. Run is finished if the previous call was more than
+// cfg.n1Gap ago
+func (a *analyzer) addRun(c call, fingerprint string) {
+	key := runKey{txID: c.txID, fingerprint: fingerprint}
+
+	r, ok := a.runs[key]
+	if ok && c.time.Sub(r.last) > a.cfg.n1Gap {
+		a.finishRun(key, r)
+		ok = false
+	}
+	if !ok {
+		r = &run{fingerprint: fingerprint, txID: c.txID, start: c.time.Add(-c.duration)}
+		a.runs[key] = r
+	}
+
+	r.last = c.time
+	r.count++
+	r.total += c.duration
+}
+
+func (a *analyzer) finishRun(key runKey, r *run) {
+	delete(a.runs, key)
+	if r.count >= a.cfg.n1Threshold {
+		a.bursts = append(a.bursts, *r)
+	}
+}
+
+// finish finishes all runs, it must be called after all lines are read
+func (a *analyzer) finish() {
+	for key, r := range a.runs {
+		a.finishRun(key, r)
+	}
+
+	slices.SortFunc(a.bursts, func(x, y run) int {
+		return cmp.Or(cmp.Compare(y.count, x.count), x.start.Compare(y.start))
+	})
+	slices.SortFunc(a.nPlusOnes, func(x, y nPlusOne) int {
+		return cmp.Or(cmp.Compare(y.count, x.count), x.time.Compare(y.time))
+	})
+}
+
+// percentile returns nearest-rank percentile p of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	i := int(math.Ceil(float64(len(sorted))*p)) - 1
+	return sorted[max(0, min(i, len(sorted)-1))]
+}
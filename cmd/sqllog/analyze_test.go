@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAnalyzer(t *testing.T) {
+	var b strings.Builder
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 5 {
+		ts := start.Add(time.Duration(i) * time.Millisecond).Format(time.RFC3339Nano)
+		// Concurrent requests outside transactions
+		_, _ = fmt.Fprintf(&b, `{"time":%q,"event":"query","query":"SELECT * FROM users WHERE id = %d"}`+"\n", ts, i)
+		// N+1 within transaction, every query falls back to prepared statement
+		_, _ = fmt.Fprintf(&b, `{"time":%q,"event":"query","tx_id":7,"query":"SELECT * FROM orders WHERE id = %d",`+
+			`"err":"driver: skip fast-path; continue as if unimplemented","err_class":"skip"}`+"\n", ts, i)
+		_, _ = fmt.Fprintf(&b, `{"time":%q,"event":"query_prepared_statement","tx_id":7,`+
+			`"query":"SELECT * FROM orders WHERE id = %d"}`+"\n", ts, i)
+	}
+
+	a := newAnalyzer(config{n1Threshold: 3, n1Gap: 100 * time.Millisecond})
+	if err := a.read(strings.NewReader(b.String())); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	a.finish()
+
+	if a.calls != 10 || len(a.errors) != 0 {
+		t.Errorf("expected 10 calls without errors, got %d calls and %d errors", a.calls, len(a.errors))
+	}
+	if len(a.bursts) != 1 || a.bursts[0].txID != 7 || a.bursts[0].count != 5 {
+		t.Errorf("expected single burst of 5 calls in transaction, got %+v", a.bursts)
+	}
+}
+
+func TestAnalyzerNPlusOne(t *testing.T) {
+	const input = `{"time":"2026-01-01T00:00:00Z","event":"n_plus_one","fingerprint":"SELECT * FROM orders WHERE id = ?",` +
+		`"count":12,"callers":["app.loadOrders","app.handler"]}` + "\n" +
+		`{"time":"2026-01-01T00:00:01Z","event":"n_plus_one","tx_id":3,"fingerprint":"SELECT * FROM users WHERE id = ?",` +
+		`"count":4,"callers":["app.loadUsers"]}` + "\n"
+
+	var b strings.Builder
+	err := analyze(config{top: 1, n1Threshold: 10, n1Gap: time.Second}, nil, strings.NewReader(input), &b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := b.String()
+	for _, want := range []string{
+		"SELECT * FROM orders WHERE id = ?", "app.loadOrders, app.handler", "... and 1 more",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected report to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "app.loadUsers") {
+		t.Errorf("expected report to be limited by top, got:\n%s", out)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	valid := config{top: 20, slow: 10, n1Threshold: 10, n1Gap: 100 * time.Millisecond}
+	if err := valid.validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, modify := range map[string]func(*config){
+		"top":    func(c *config) { c.top = -1 },
+		"slow":   func(c *config) { c.slow = -1 },
+		"n1":     func(c *config) { c.n1Threshold = 0 },
+		"n1-gap": func(c *config) { c.n1Gap = -time.Second },
+	} {
+		c := valid
+		modify(&c)
+		if err := c.validate(); err == nil {
+			t.Errorf("expected error for invalid -%s", name)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Command sqllog analyzes JSON Lines written by [sink.Logger] and prints reports: top queries by total time with
+// latency percentiles per fingerprint, errors, the slowest calls, distribution of transaction durations and N+1
+// queries. Calls that failed with [driver.ErrSkip] are skipped since [database/sql] repeats them another way.
+//
+// N+1 queries detected by budget of logsql connector (n_plus_one events) are reported along with their callers. In
+// addition, bursts of repeated SELECT queries are detected within transactions, lines do not tell calls of
+// concurrent connections outside transactions apart.
+//
+// Usage:
+//
+//	sqllog [flags] [file ...]
+//
+// Files are read in order, standard input is read if no files are given. Flags:
+//
+//	-top int           number of fingerprints and errors in reports (default 20)
+//	-slow int          number of the slowest calls in report (default 10)
+//	-n1 int            minimum number of repeated SELECT queries in transaction reported as N+1 burst (default 10)
+//	-n1-gap duration   maximum time between repeated queries of the same burst (default 100ms)
+//
+// [sink.Logger]: https://pkg.go.dev/github.com/alsiberij/sqlutils/logsql/sink#Logger
+// [driver.ErrSkip]: https://pkg.go.dev/database/sql/driver#ErrSkip
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+func main() {
+	var cfg config
+	flag.IntVar(&cfg.top, "top", 20, "number of fingerprints and errors in reports")
+	flag.IntVar(&cfg.slow, "slow", 10, "number of the slowest calls in report")
+	flag.IntVar(&cfg.n1Threshold, "n1", 10, "minimum number of repeated SELECT queries in transaction reported as N+1 burst")
+	flag.DurationVar(&cfg.n1Gap, "n1-gap", 100*time.Millisecond, "maximum time between repeated queries of the same burst")
+	flag.Parse()
+
+	if err := cfg.validate(); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, "sqllog:", err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	err := analyze(cfg, flag.Args(), os.Stdin, os.Stdout)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, "sqllog:", err)
+		os.Exit(1)
+	}
+}
+
+// analyze reads lines of files at paths or stdin and writes reports to w
+func analyze(cfg config, paths []string, stdin io.Reader, w io.Writer) error {
+	a := newAnalyzer(cfg)
+
+	if len(paths) == 0 {
+		err := a.read(stdin)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, path := range paths {
+		err := readFile(a, path)
+		if err != nil {
+			return err
+		}
+	}
+
+	a.finish()
+	a.report(w)
+
+	return nil
+}
+
+func readFile(a *analyzer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	err = a.read(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// txBuckets are upper bounds of transaction duration histogram, the last bucket is unbounded
+var txBuckets = []time.Duration{
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+}
+
+// report prints all reports to w
+func (a *analyzer) report(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "Lines: %d, malformed: %d, calls: %d, fingerprints: %d\n",
+		a.lines, a.malformed, a.calls, len(a.fingerprints))
+
+	a.reportFingerprints(w)
+	a.reportErrors(w)
+	a.reportSlowest(w)
+	a.reportTxs(w)
+	a.reportBursts(w)
+	a.reportNPlusOnes(w)
+}
+
+func (a *analyzer) reportFingerprints(w io.Writer) {
+	section(w, "Top queries by total time")
+
+	stats := slices.SortedFunc(maps.Values(a.fingerprints), func(x, y *fingerprintStats) int {
+		return cmp.Or(cmp.Compare(y.total, x.total), cmp.Compare(x.fingerprint, y.fingerprint))
+	})
+
+	tw := newTable(w, "TOTAL", "COUNT", "ERRORS", "P50", "P95", "P99", "MAX", "QUERY")
+	for _, fs := range stats[:min(a.cfg.top, len(stats))] {
+		slices.Sort(fs.durations)
+		row(tw, fs.total, len(fs.durations), fs.errors, percentile(fs.durations, 0.5), percentile(fs.durations, 0.95),
+			percentile(fs.durations, 0.99), fs.durations[len(fs.durations)-1], fs.fingerprint)
+	}
+	_ = tw.Flush()
+}
+
+func (a *analyzer) reportErrors(w io.Writer) {
+	section(w, "Errors")
+
+	stats := slices.SortedFunc(maps.Values(a.errors), func(x, y *errorStats) int {
+		return cmp.Or(cmp.Compare(y.count, x.count), cmp.Compare(x.err, y.err))
+	})
+
+	tw := newTable(w, "COUNT", "QUERIES", "TOP QUERY", "ERROR")
+	for _, es := range stats[:min(a.cfg.top, len(stats))] {
+		top := slices.SortedFunc(maps.Keys(es.fingerprints), func(x, y string) int {
+			return cmp.Or(cmp.Compare(es.fingerprints[y], es.fingerprints[x]), cmp.Compare(x, y))
+		})[0]
+		row(tw, es.count, len(es.fingerprints), top, es.err)
+	}
+	_ = tw.Flush()
+}
+
+func (a *analyzer) reportSlowest(w io.Writer) {
+	section(w, "Slowest calls")
+
+	tw := newTable(w, "DURATION", "TIME", "TX", "QUERY")
+	for _, c := range a.slowest {
+		row(tw, c.duration, c.time.Format(time.RFC3339Nano), txID(c.txID), c.query)
+	}
+	_ = tw.Flush()
+}
+
+func (a *analyzer) reportTxs(w io.Writer) {
+	section(w, "Transactions")
+
+	durations := a.txStats.durations
+	slices.Sort(durations)
+
+	_, _ = fmt.Fprintf(w, "Committed: %d, rolled back: %d, failed to finish: %d, unfinished: %d\n",
+		a.txStats.commits, a.txStats.rollbacks, a.txStats.failed, len(a.txs))
+	if len(durations) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(w, "P50: %s, P95: %s, P99: %s, max: %s\n", percentile(durations, 0.5),
+		percentile(durations, 0.95), percentile(durations, 0.99), durations[len(durations)-1])
+
+	tw := newTable(w, "DURATION", "COUNT", "HISTOGRAM")
+	lower := 0
+	for i := 0; i <= len(txBuckets); i++ {
+		label := fmt.Sprintf(">= %s", txBuckets[len(txBuckets)-1])
+		upper := len(durations)
+		if i < len(txBuckets) {
+			label = fmt.Sprintf("< %s", txBuckets[i])
+			upper, _ = slices.BinarySearch(durations, txBuckets[i])
+		}
+		row(tw, label, upper-lower, histogramBar(upper-lower, len(durations)))
+		lower = upper
+	}
+	_ = tw.Flush()
+}
+
+func (a *analyzer) reportBursts(w io.Writer) {
+	section(w, "N+1 bursts")
+
+	tw := newTable(w, "COUNT", "TOTAL", "SPAN", "START", "TX", "QUERY")
+	for _, b := range a.bursts[:min(a.cfg.top, len(a.bursts))] {
+		row(tw, b.count, b.total, b.last.Sub(b.start), b.start.Format(time.RFC3339Nano), txID(b.txID), b.fingerprint)
+	}
+	_ = tw.Flush()
+
+	if len(a.bursts) > a.cfg.top {
+		_, _ = fmt.Fprintf(w, "... and %d more\n", len(a.bursts)-a.cfg.top)
+	}
+}
+
+func (a *analyzer) reportNPlusOnes(w io.Writer) {
+	section(w, "N+1 detected by budget")
+
+	tw := newTable(w, "COUNT", "TIME", "TX", "QUERY", "CALLERS")
+	for _, n := range a.nPlusOnes[:min(a.cfg.top, len(a.nPlusOnes))] {
+		row(tw, n.count, n.time.Format(time.RFC3339Nano), txID(n.txID), n.fingerprint, strings.Join(n.callers, ", "))
+	}
+	_ = tw.Flush()
+
+	if len(a.nPlusOnes) > a.cfg.top {
+		_, _ = fmt.Fprintf(w, "... and %d more\n", len(a.nPlusOnes)-a.cfg.top)
+	}
+}
+
+func section(w io.Writer, title string) {
+	_, _ = fmt.Fprintf(w, "\n== %s ==\n", title)
+}
+
+func newTable(w io.Writer, header ...any) *tabwriter.Writer {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	row(tw, header...)
+
+	return tw
+}
+
+func row(tw *tabwriter.Writer, columns ...any) {
+	for i, c := range columns {
+		if i > 0 {
+			_, _ = fmt.Fprint(tw, "\t")
+		}
+		_, _ = fmt.Fprint(tw, c)
+	}
+	_, _ = fmt.Fprintln(tw)
+}
+
+// txID formats transaction ID, empty for calls outside transactions
+func txID(id uint64) string {
+	if id == 0 {
+		return "-"
+	}
+
+	return fmt.Sprint(id)
+}
+
+// histogramBar returns bar of length proportional to share of n in total
+func histogramBar(n, total int) string {
+	const width = 40
+
+	return strings.Repeat("#", n*width/total)
+}
@@ -0,0 +1,10878 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package fakedriver
+
+import "database/sql/driver"
+
+func composeConn(c *conn, f Feature) driver.Conn {
+	return connCompositions[f.conn()](c)
+}
+
+var connCompositions = [...]func(c *conn) driver.Conn{
+	0: func(c *conn) driver.Conn { return c },
+	1: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+		}{c, connBeginTx{c}}
+	},
+	2: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+		}{c, connPrepareContext{c}}
+	},
+	3: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+		}{c, connBeginTx{c}, connPrepareContext{c}}
+	},
+	4: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+		}{c, connExecer{c}}
+	},
+	5: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+		}{c, connBeginTx{c}, connExecer{c}}
+	},
+	6: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+		}{c, connPrepareContext{c}, connExecer{c}}
+	},
+	7: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}}
+	},
+	8: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+		}{c, connExecerContext{c}}
+	},
+	9: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+		}{c, connBeginTx{c}, connExecerContext{c}}
+	},
+	10: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+		}{c, connPrepareContext{c}, connExecerContext{c}}
+	},
+	11: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}}
+	},
+	12: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+		}{c, connExecer{c}, connExecerContext{c}}
+	},
+	13: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}}
+	},
+	14: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}}
+	},
+	15: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}}
+	},
+	16: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+		}{c, connQueryer{c}}
+	},
+	17: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+		}{c, connBeginTx{c}, connQueryer{c}}
+	},
+	18: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+		}{c, connPrepareContext{c}, connQueryer{c}}
+	},
+	19: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}}
+	},
+	20: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+		}{c, connExecer{c}, connQueryer{c}}
+	},
+	21: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}}
+	},
+	22: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}}
+	},
+	23: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}}
+	},
+	24: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+		}{c, connExecerContext{c}, connQueryer{c}}
+	},
+	25: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}}
+	},
+	26: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}}
+	},
+	27: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}}
+	},
+	28: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}}
+	},
+	29: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}}
+	},
+	30: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}}
+	},
+	31: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}}
+	},
+	32: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+		}{c, connQueryerContext{c}}
+	},
+	33: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+		}{c, connBeginTx{c}, connQueryerContext{c}}
+	},
+	34: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+		}{c, connPrepareContext{c}, connQueryerContext{c}}
+	},
+	35: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}}
+	},
+	36: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+		}{c, connExecer{c}, connQueryerContext{c}}
+	},
+	37: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}}
+	},
+	38: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}}
+	},
+	39: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}}
+	},
+	40: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+		}{c, connExecerContext{c}, connQueryerContext{c}}
+	},
+	41: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}}
+	},
+	42: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}}
+	},
+	43: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}}
+	},
+	44: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}}
+	},
+	45: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}}
+	},
+	46: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}}
+	},
+	47: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}}
+	},
+	48: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+		}{c, connQueryer{c}, connQueryerContext{c}}
+	},
+	49: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	50: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	51: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	52: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	53: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	54: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	55: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	56: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	57: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	58: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	59: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	60: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	61: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	62: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	63: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}}
+	},
+	64: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPinger
+		}{c, connPinger{c}}
+	},
+	65: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPinger
+		}{c, connBeginTx{c}, connPinger{c}}
+	},
+	66: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connPinger
+		}{c, connPrepareContext{c}, connPinger{c}}
+	},
+	67: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connPinger{c}}
+	},
+	68: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connPinger
+		}{c, connExecer{c}, connPinger{c}}
+	},
+	69: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connPinger
+		}{c, connBeginTx{c}, connExecer{c}, connPinger{c}}
+	},
+	70: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connPinger
+		}{c, connPrepareContext{c}, connExecer{c}, connPinger{c}}
+	},
+	71: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connPinger{c}}
+	},
+	72: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connPinger
+		}{c, connExecerContext{c}, connPinger{c}}
+	},
+	73: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connPinger
+		}{c, connBeginTx{c}, connExecerContext{c}, connPinger{c}}
+	},
+	74: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connPinger
+		}{c, connPrepareContext{c}, connExecerContext{c}, connPinger{c}}
+	},
+	75: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connPinger{c}}
+	},
+	76: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connPinger
+		}{c, connExecer{c}, connExecerContext{c}, connPinger{c}}
+	},
+	77: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connPinger
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connPinger{c}}
+	},
+	78: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}}
+	},
+	79: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}}
+	},
+	80: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connPinger
+		}{c, connQueryer{c}, connPinger{c}}
+	},
+	81: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connPinger
+		}{c, connBeginTx{c}, connQueryer{c}, connPinger{c}}
+	},
+	82: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connPinger
+		}{c, connPrepareContext{c}, connQueryer{c}, connPinger{c}}
+	},
+	83: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connPinger{c}}
+	},
+	84: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connPinger
+		}{c, connExecer{c}, connQueryer{c}, connPinger{c}}
+	},
+	85: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connPinger
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connPinger{c}}
+	},
+	86: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}}
+	},
+	87: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}}
+	},
+	88: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connPinger
+		}{c, connExecerContext{c}, connQueryer{c}, connPinger{c}}
+	},
+	89: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connPinger
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}}
+	},
+	90: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}}
+	},
+	91: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}}
+	},
+	92: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}}
+	},
+	93: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}}
+	},
+	94: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}}
+	},
+	95: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}}
+	},
+	96: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connPinger
+		}{c, connQueryerContext{c}, connPinger{c}}
+	},
+	97: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	98: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connPinger
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	99: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	100: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connPinger
+		}{c, connExecer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	101: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	102: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	103: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	104: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connPinger
+		}{c, connExecerContext{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	105: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	106: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	107: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	108: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	109: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	110: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	111: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	112: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	113: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	114: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	115: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	116: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	117: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	118: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	119: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	120: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	121: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	122: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	123: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	124: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	125: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	126: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	127: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}}
+	},
+	128: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connSessionResetter
+		}{c, connSessionResetter{c}}
+	},
+	129: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connSessionResetter
+		}{c, connBeginTx{c}, connSessionResetter{c}}
+	},
+	130: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connSessionResetter{c}}
+	},
+	131: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connSessionResetter{c}}
+	},
+	132: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connSessionResetter
+		}{c, connExecer{c}, connSessionResetter{c}}
+	},
+	133: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connSessionResetter{c}}
+	},
+	134: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connSessionResetter{c}}
+	},
+	135: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connSessionResetter{c}}
+	},
+	136: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connSessionResetter
+		}{c, connExecerContext{c}, connSessionResetter{c}}
+	},
+	137: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecerContext{c}, connSessionResetter{c}}
+	},
+	138: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecerContext{c}, connSessionResetter{c}}
+	},
+	139: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connSessionResetter{c}}
+	},
+	140: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connSessionResetter
+		}{c, connExecer{c}, connExecerContext{c}, connSessionResetter{c}}
+	},
+	141: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}}
+	},
+	142: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}}
+	},
+	143: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}}
+	},
+	144: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connSessionResetter
+		}{c, connQueryer{c}, connSessionResetter{c}}
+	},
+	145: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connSessionResetter
+		}{c, connBeginTx{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	146: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connSessionResetter
+		}{c, connPrepareContext{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	147: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	148: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connSessionResetter
+		}{c, connExecer{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	149: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	150: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	151: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	152: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connSessionResetter
+		}{c, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	153: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	154: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	155: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	156: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	157: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	158: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	159: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}}
+	},
+	160: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connSessionResetter
+		}{c, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	161: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	162: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	163: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	164: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connSessionResetter
+		}{c, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	165: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	166: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	167: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	168: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+		}{c, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	169: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	170: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	171: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	172: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	173: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	174: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	175: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	176: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	177: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	178: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	179: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	180: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	181: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	182: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	183: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	184: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	185: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	186: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	187: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	188: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	189: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	190: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	191: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}}
+	},
+	192: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPinger
+			connSessionResetter
+		}{c, connPinger{c}, connSessionResetter{c}}
+	},
+	193: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	194: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	195: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	196: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connPinger
+			connSessionResetter
+		}{c, connExecer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	197: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	198: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	199: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	200: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connPinger
+			connSessionResetter
+		}{c, connExecerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	201: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	202: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	203: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	204: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+		}{c, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	205: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	206: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	207: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	208: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	209: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	210: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	211: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	212: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	213: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	214: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	215: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	216: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	217: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	218: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	219: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	220: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	221: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	222: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	223: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	224: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	225: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	226: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	227: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	228: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	229: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	230: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	231: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	232: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	233: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	234: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	235: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	236: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	237: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	238: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	239: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	240: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	241: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	242: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	243: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	244: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	245: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	246: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	247: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	248: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	249: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	250: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	251: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	252: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	253: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	254: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	255: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}}
+	},
+	256: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connValidator
+		}{c, connValidator{c}}
+	},
+	257: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connValidator
+		}{c, connBeginTx{c}, connValidator{c}}
+	},
+	258: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connValidator
+		}{c, connPrepareContext{c}, connValidator{c}}
+	},
+	259: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connValidator{c}}
+	},
+	260: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connValidator
+		}{c, connExecer{c}, connValidator{c}}
+	},
+	261: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connValidator{c}}
+	},
+	262: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connValidator{c}}
+	},
+	263: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connValidator{c}}
+	},
+	264: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connValidator
+		}{c, connExecerContext{c}, connValidator{c}}
+	},
+	265: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connValidator{c}}
+	},
+	266: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connValidator{c}}
+	},
+	267: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connValidator{c}}
+	},
+	268: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connValidator{c}}
+	},
+	269: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connValidator{c}}
+	},
+	270: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connValidator{c}}
+	},
+	271: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connValidator{c}}
+	},
+	272: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connValidator
+		}{c, connQueryer{c}, connValidator{c}}
+	},
+	273: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connValidator
+		}{c, connBeginTx{c}, connQueryer{c}, connValidator{c}}
+	},
+	274: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connValidator
+		}{c, connPrepareContext{c}, connQueryer{c}, connValidator{c}}
+	},
+	275: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connValidator{c}}
+	},
+	276: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connValidator
+		}{c, connExecer{c}, connQueryer{c}, connValidator{c}}
+	},
+	277: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connValidator{c}}
+	},
+	278: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connValidator{c}}
+	},
+	279: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connValidator{c}}
+	},
+	280: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connValidator
+		}{c, connExecerContext{c}, connQueryer{c}, connValidator{c}}
+	},
+	281: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}}
+	},
+	282: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}}
+	},
+	283: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}}
+	},
+	284: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}}
+	},
+	285: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}}
+	},
+	286: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}}
+	},
+	287: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}}
+	},
+	288: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connValidator
+		}{c, connQueryerContext{c}, connValidator{c}}
+	},
+	289: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	290: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connValidator
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	291: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	292: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connValidator
+		}{c, connExecer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	293: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	294: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	295: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	296: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connValidator
+		}{c, connExecerContext{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	297: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	298: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	299: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	300: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	301: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	302: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	303: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	304: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	305: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	306: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	307: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	308: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	309: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	310: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	311: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	312: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	313: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	314: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	315: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	316: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	317: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	318: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	319: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}}
+	},
+	320: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPinger
+			connValidator
+		}{c, connPinger{c}, connValidator{c}}
+	},
+	321: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPinger{c}, connValidator{c}}
+	},
+	322: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connPinger{c}, connValidator{c}}
+	},
+	323: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connPinger{c}, connValidator{c}}
+	},
+	324: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connPinger
+			connValidator
+		}{c, connExecer{c}, connPinger{c}, connValidator{c}}
+	},
+	325: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connPinger{c}, connValidator{c}}
+	},
+	326: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connPinger{c}, connValidator{c}}
+	},
+	327: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connPinger{c}, connValidator{c}}
+	},
+	328: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connPinger
+			connValidator
+		}{c, connExecerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	329: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	330: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	331: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	332: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connPinger
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	333: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	334: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	335: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	336: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	337: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	338: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	339: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	340: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connExecer{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	341: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	342: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	343: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	344: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	345: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	346: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	347: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	348: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	349: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	350: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	351: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}}
+	},
+	352: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	353: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	354: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	355: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	356: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connExecer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	357: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	358: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	359: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	360: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	361: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	362: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	363: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	364: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	365: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	366: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	367: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	368: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	369: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	370: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	371: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	372: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	373: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	374: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	375: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	376: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	377: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	378: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	379: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	380: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	381: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	382: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	383: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}}
+	},
+	384: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connSessionResetter
+			connValidator
+		}{c, connSessionResetter{c}, connValidator{c}}
+	},
+	385: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	386: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	387: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	388: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	389: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	390: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	391: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	392: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connSessionResetter
+			connValidator
+		}{c, connExecerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	393: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	394: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	395: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	396: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	397: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	398: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	399: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	400: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	401: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	402: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	403: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	404: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	405: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	406: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	407: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	408: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	409: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	410: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	411: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	412: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	413: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	414: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	415: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	416: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	417: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	418: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	419: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	420: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	421: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	422: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	423: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	424: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	425: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	426: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	427: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	428: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	429: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	430: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	431: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	432: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	433: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	434: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	435: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	436: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	437: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	438: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	439: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	440: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	441: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	442: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	443: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	444: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	445: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	446: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	447: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	448: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	449: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	450: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	451: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	452: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	453: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	454: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	455: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	456: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	457: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	458: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	459: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	460: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	461: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	462: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	463: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	464: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	465: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	466: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	467: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	468: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	469: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	470: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	471: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	472: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	473: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	474: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	475: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	476: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	477: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	478: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	479: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	480: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	481: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	482: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	483: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	484: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	485: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	486: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	487: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	488: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	489: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	490: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	491: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	492: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	493: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	494: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	495: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	496: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	497: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	498: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	499: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	500: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	501: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	502: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	503: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	504: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	505: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	506: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	507: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	508: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	509: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	510: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	511: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}}
+	},
+	512: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connNamedValueChecker
+		}{c, connNamedValueChecker{c}}
+	},
+	513: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connNamedValueChecker{c}}
+	},
+	514: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connNamedValueChecker{c}}
+	},
+	515: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connNamedValueChecker{c}}
+	},
+	516: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connNamedValueChecker
+		}{c, connExecer{c}, connNamedValueChecker{c}}
+	},
+	517: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connNamedValueChecker{c}}
+	},
+	518: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connNamedValueChecker{c}}
+	},
+	519: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connNamedValueChecker{c}}
+	},
+	520: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connNamedValueChecker{c}}
+	},
+	521: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connNamedValueChecker{c}}
+	},
+	522: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connNamedValueChecker{c}}
+	},
+	523: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connNamedValueChecker{c}}
+	},
+	524: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connNamedValueChecker{c}}
+	},
+	525: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connNamedValueChecker{c}}
+	},
+	526: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connNamedValueChecker{c}}
+	},
+	527: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connNamedValueChecker{c}}
+	},
+	528: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connNamedValueChecker
+		}{c, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	529: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	530: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	531: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	532: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	533: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	534: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	535: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	536: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	537: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	538: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	539: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	540: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	541: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	542: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	543: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connNamedValueChecker{c}}
+	},
+	544: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	545: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	546: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	547: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	548: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	549: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	550: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	551: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	552: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	553: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	554: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	555: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	556: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	557: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	558: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	559: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	560: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	561: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	562: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	563: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	564: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	565: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	566: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	567: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	568: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	569: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	570: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	571: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	572: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	573: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	574: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	575: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connNamedValueChecker{c}}
+	},
+	576: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPinger
+			connNamedValueChecker
+		}{c, connPinger{c}, connNamedValueChecker{c}}
+	},
+	577: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	578: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	579: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	580: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connPinger
+			connNamedValueChecker
+		}{c, connExecer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	581: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	582: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	583: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	584: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	585: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	586: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	587: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	588: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	589: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	590: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	591: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	592: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	593: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	594: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	595: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	596: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	597: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	598: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	599: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	600: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	601: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	602: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	603: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	604: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	605: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	606: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	607: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	608: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	609: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	610: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	611: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	612: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	613: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	614: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	615: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	616: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	617: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	618: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	619: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	620: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	621: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	622: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	623: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	624: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	625: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	626: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	627: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	628: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	629: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	630: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	631: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	632: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	633: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	634: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	635: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	636: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	637: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	638: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	639: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connNamedValueChecker{c}}
+	},
+	640: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	641: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	642: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	643: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	644: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	645: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	646: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	647: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	648: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	649: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	650: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	651: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	652: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	653: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	654: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	655: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	656: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	657: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	658: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	659: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	660: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	661: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	662: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	663: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	664: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	665: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	666: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	667: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	668: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	669: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	670: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	671: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	672: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	673: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	674: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	675: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	676: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	677: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	678: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	679: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	680: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	681: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	682: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	683: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	684: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	685: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	686: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	687: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	688: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	689: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	690: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	691: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	692: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	693: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	694: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	695: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	696: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	697: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	698: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	699: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	700: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	701: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	702: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	703: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	704: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	705: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	706: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	707: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	708: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	709: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	710: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	711: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	712: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	713: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	714: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	715: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	716: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	717: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	718: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	719: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	720: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	721: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	722: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	723: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	724: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	725: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	726: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	727: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	728: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	729: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	730: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	731: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	732: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	733: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	734: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	735: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	736: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	737: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	738: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	739: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	740: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	741: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	742: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	743: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	744: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	745: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	746: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	747: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	748: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	749: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	750: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	751: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	752: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	753: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	754: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	755: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	756: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	757: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	758: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	759: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	760: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	761: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	762: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	763: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	764: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	765: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	766: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	767: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connNamedValueChecker{c}}
+	},
+	768: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connValidator
+			connNamedValueChecker
+		}{c, connValidator{c}, connNamedValueChecker{c}}
+	},
+	769: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	770: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	771: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	772: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	773: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	774: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	775: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	776: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	777: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	778: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	779: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	780: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	781: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	782: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	783: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	784: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	785: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	786: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	787: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	788: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	789: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	790: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	791: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	792: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	793: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	794: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	795: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	796: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	797: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	798: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	799: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	800: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	801: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	802: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	803: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	804: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	805: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	806: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	807: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	808: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	809: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	810: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	811: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	812: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	813: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	814: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	815: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	816: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	817: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	818: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	819: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	820: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	821: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	822: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	823: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	824: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	825: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	826: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	827: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	828: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	829: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	830: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	831: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	832: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	833: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	834: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	835: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	836: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	837: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	838: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	839: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	840: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	841: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	842: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	843: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	844: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	845: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	846: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	847: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	848: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	849: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	850: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	851: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	852: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	853: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	854: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	855: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	856: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	857: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	858: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	859: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	860: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	861: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	862: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	863: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	864: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	865: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	866: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	867: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	868: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	869: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	870: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	871: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	872: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	873: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	874: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	875: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	876: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	877: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	878: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	879: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	880: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	881: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	882: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	883: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	884: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	885: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	886: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	887: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	888: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	889: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	890: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	891: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	892: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	893: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	894: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	895: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	896: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	897: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	898: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	899: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	900: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	901: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	902: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	903: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	904: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	905: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	906: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	907: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	908: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	909: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	910: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	911: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	912: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	913: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	914: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	915: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	916: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	917: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	918: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	919: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	920: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	921: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	922: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	923: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	924: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	925: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	926: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	927: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	928: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	929: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	930: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	931: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	932: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	933: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	934: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	935: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	936: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	937: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	938: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	939: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	940: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	941: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	942: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	943: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	944: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	945: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	946: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	947: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	948: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	949: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	950: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	951: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	952: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	953: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	954: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	955: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	956: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	957: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	958: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	959: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	960: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	961: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	962: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	963: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	964: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	965: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	966: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	967: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	968: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	969: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	970: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	971: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	972: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	973: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	974: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	975: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	976: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	977: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	978: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	979: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	980: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	981: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	982: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	983: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	984: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	985: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	986: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	987: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	988: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	989: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	990: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	991: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	992: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	993: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	994: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	995: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	996: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	997: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	998: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	999: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1000: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1001: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1002: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1003: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1004: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1005: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1006: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1007: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1008: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1009: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1010: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1011: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1012: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1013: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1014: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1015: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1016: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1017: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1018: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1019: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1020: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1021: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1022: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+	1023: func(c *conn) driver.Conn {
+		return struct {
+			*conn
+			connBeginTx
+			connPrepareContext
+			connExecer
+			connExecerContext
+			connQueryer
+			connQueryerContext
+			connPinger
+			connSessionResetter
+			connValidator
+			connNamedValueChecker
+		}{c, connBeginTx{c}, connPrepareContext{c}, connExecer{c}, connExecerContext{c}, connQueryer{c}, connQueryerContext{c}, connPinger{c}, connSessionResetter{c}, connValidator{c}, connNamedValueChecker{c}}
+	},
+}
+
+func composeStmt(s *stmt, f Feature) driver.Stmt {
+	return stmtCompositions[f.stmt()](s)
+}
+
+var stmtCompositions = [...]func(s *stmt) driver.Stmt{
+	0: func(s *stmt) driver.Stmt { return s },
+	1: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtExecContext
+		}{s, stmtExecContext{s}}
+	},
+	2: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtQueryContext
+		}{s, stmtQueryContext{s}}
+	},
+	3: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtExecContext
+			stmtQueryContext
+		}{s, stmtExecContext{s}, stmtQueryContext{s}}
+	},
+	4: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtNamedValueChecker
+		}{s, stmtNamedValueChecker{s}}
+	},
+	5: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtExecContext
+			stmtNamedValueChecker
+		}{s, stmtExecContext{s}, stmtNamedValueChecker{s}}
+	},
+	6: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtQueryContext
+			stmtNamedValueChecker
+		}{s, stmtQueryContext{s}, stmtNamedValueChecker{s}}
+	},
+	7: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtExecContext
+			stmtQueryContext
+			stmtNamedValueChecker
+		}{s, stmtExecContext{s}, stmtQueryContext{s}, stmtNamedValueChecker{s}}
+	},
+	8: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtColumnConverter
+		}{s, stmtColumnConverter{s}}
+	},
+	9: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtExecContext
+			stmtColumnConverter
+		}{s, stmtExecContext{s}, stmtColumnConverter{s}}
+	},
+	10: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtQueryContext
+			stmtColumnConverter
+		}{s, stmtQueryContext{s}, stmtColumnConverter{s}}
+	},
+	11: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtExecContext
+			stmtQueryContext
+			stmtColumnConverter
+		}{s, stmtExecContext{s}, stmtQueryContext{s}, stmtColumnConverter{s}}
+	},
+	12: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtNamedValueChecker
+			stmtColumnConverter
+		}{s, stmtNamedValueChecker{s}, stmtColumnConverter{s}}
+	},
+	13: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtExecContext
+			stmtNamedValueChecker
+			stmtColumnConverter
+		}{s, stmtExecContext{s}, stmtNamedValueChecker{s}, stmtColumnConverter{s}}
+	},
+	14: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtQueryContext
+			stmtNamedValueChecker
+			stmtColumnConverter
+		}{s, stmtQueryContext{s}, stmtNamedValueChecker{s}, stmtColumnConverter{s}}
+	},
+	15: func(s *stmt) driver.Stmt {
+		return struct {
+			*stmt
+			stmtExecContext
+			stmtQueryContext
+			stmtNamedValueChecker
+			stmtColumnConverter
+		}{s, stmtExecContext{s}, stmtQueryContext{s}, stmtNamedValueChecker{s}, stmtColumnConverter{s}}
+	},
+}
+
+func composeRows(r *rows, f Feature) driver.Rows {
+	return rowsCompositions[f.rows()](r)
+}
+
+var rowsCompositions = [...]func(r *rows) driver.Rows{
+	0: func(r *rows) driver.Rows { return r },
+	1: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+		}{r, rowsNextResultSet{r}}
+	},
+	2: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+		}{r, rowsColumnTypeScanType{r}}
+	},
+	3: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}}
+	},
+	4: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeDatabaseTypeName
+		}{r, rowsColumnTypeDatabaseTypeName{r}}
+	},
+	5: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+		}{r, rowsNextResultSet{r}, rowsColumnTypeDatabaseTypeName{r}}
+	},
+	6: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}}
+	},
+	7: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}}
+	},
+	8: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeLength
+		}{r, rowsColumnTypeLength{r}}
+	},
+	9: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+		}{r, rowsNextResultSet{r}, rowsColumnTypeLength{r}}
+	},
+	10: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeLength{r}}
+	},
+	11: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeLength{r}}
+	},
+	12: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{r, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}}
+	},
+	13: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{r, rowsNextResultSet{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}}
+	},
+	14: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}}
+	},
+	15: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}}
+	},
+	16: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeNullable
+		}{r, rowsColumnTypeNullable{r}}
+	},
+	17: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeNullable
+		}{r, rowsNextResultSet{r}, rowsColumnTypeNullable{r}}
+	},
+	18: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeNullable{r}}
+	},
+	19: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeNullable{r}}
+	},
+	20: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{r, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeNullable{r}}
+	},
+	21: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{r, rowsNextResultSet{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeNullable{r}}
+	},
+	22: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeNullable{r}}
+	},
+	23: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeNullable{r}}
+	},
+	24: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{r, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}}
+	},
+	25: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{r, rowsNextResultSet{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}}
+	},
+	26: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}}
+	},
+	27: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}}
+	},
+	28: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{r, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}}
+	},
+	29: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{r, rowsNextResultSet{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}}
+	},
+	30: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}}
+	},
+	31: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}}
+	},
+	32: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypePrecisionScale{r}}
+	},
+	33: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	34: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	35: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	36: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	37: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	38: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	39: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	40: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeLength{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	41: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeLength{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	42: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeLength{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	43: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeLength{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	44: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	45: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	46: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	47: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	48: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	49: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	50: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	51: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	52: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	53: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	54: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	55: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	56: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	57: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	58: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	59: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	60: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	61: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	62: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+	63: func(r *rows) driver.Rows {
+		return struct {
+			*rows
+			rowsNextResultSet
+			rowsColumnTypeScanType
+			rowsColumnTypeDatabaseTypeName
+			rowsColumnTypeLength
+			rowsColumnTypeNullable
+			rowsColumnTypePrecisionScale
+		}{r, rowsNextResultSet{r}, rowsColumnTypeScanType{r}, rowsColumnTypeDatabaseTypeName{r}, rowsColumnTypeLength{r}, rowsColumnTypeNullable{r}, rowsColumnTypePrecisionScale{r}}
+	},
+}
@@ -0,0 +1,132 @@
+package fakedriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+)
+
+type (
+	// featureCheck reports whether value implements interface of feature
+	featureCheck struct {
+		feature    Feature
+		implements func(v any) bool
+	}
+)
+
+func implements[T any](v any) bool {
+	_, ok := v.(T)
+	return ok
+}
+
+var (
+	connChecks = []featureCheck{
+		{FeatureConnBeginTx, implements[driver.ConnBeginTx]},
+		{FeatureConnPrepareContext, implements[driver.ConnPrepareContext]},
+		{FeatureExecer, implements[driver.Execer]},
+		{FeatureExecerContext, implements[driver.ExecerContext]},
+		{FeatureQueryer, implements[driver.Queryer]},
+		{FeatureQueryerContext, implements[driver.QueryerContext]},
+		{FeaturePinger, implements[driver.Pinger]},
+		{FeatureSessionResetter, implements[driver.SessionResetter]},
+		{FeatureValidator, implements[driver.Validator]},
+		{FeatureConnNamedValueChecker, implements[driver.NamedValueChecker]},
+	}
+	stmtChecks = []featureCheck{
+		{FeatureStmtExecContext, implements[driver.StmtExecContext]},
+		{FeatureStmtQueryContext, implements[driver.StmtQueryContext]},
+		{FeatureStmtNamedValueChecker, implements[driver.NamedValueChecker]},
+		{FeatureColumnConverter, implements[driver.ColumnConverter]},
+	}
+	rowsChecks = []featureCheck{
+		{FeatureRowsNextResultSet, implements[driver.RowsNextResultSet]},
+		{FeatureRowsColumnTypeScanType, implements[driver.RowsColumnTypeScanType]},
+		{FeatureRowsColumnTypeDatabaseTypeName, implements[driver.RowsColumnTypeDatabaseTypeName]},
+		{FeatureRowsColumnTypeLength, implements[driver.RowsColumnTypeLength]},
+		{FeatureRowsColumnTypeNullable, implements[driver.RowsColumnTypeNullable]},
+		{FeatureRowsColumnTypePrecisionScale, implements[driver.RowsColumnTypePrecisionScale]},
+	}
+)
+
+// TestCompose checks that connections, statements and rows implement exactly the optional interfaces enabled by
+// features
+func TestCompose(t *testing.T) {
+	tests := []struct {
+		name    string
+		shift   int
+		count   int
+		checks  []featureCheck
+		compose func(d *Driver) (any, error)
+	}{
+		{"conn", connFeatureShift, connFeatureCount, connChecks, func(d *Driver) (any, error) {
+			return d.Connect(context.Background())
+		}},
+		{"stmt", stmtFeatureShift, stmtFeatureCount, stmtChecks, func(d *Driver) (any, error) {
+			return (&conn{d: d}).Prepare("SELECT 1")
+		}},
+		{"rows", rowsFeatureShift, rowsFeatureCount, rowsChecks, func(d *Driver) (any, error) {
+			return d.query(context.Background(), "SELECT 1", nil)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.checks) != tt.count {
+				t.Fatalf("%d checks for %d features", len(tt.checks), tt.count)
+			}
+
+			for mask := 0; mask < 1<<tt.count; mask++ {
+				f := Feature(mask) << tt.shift
+
+				v, err := tt.compose(New(Config{Features: f}))
+				if err != nil {
+					t.Fatalf("features %#x: %v", f, err)
+				}
+
+				for _, check := range tt.checks {
+					if check.implements(v) != f.Has(check.feature) {
+						t.Errorf("features %#x: interface of feature %#x is implemented: %v", f, check.feature,
+							!f.Has(check.feature))
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestComposeDelegates checks that optional interfaces of composed values reach the base values
+func TestComposeDelegates(t *testing.T) {
+	d := New(Config{
+		Features: FeatureAll,
+		Default: Response{
+			Columns:      []string{"a"},
+			Rows:         [][]driver.Value{{int64(1)}},
+			RowsAffected: 2,
+		},
+	})
+
+	c, err := d.Connect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := c.(driver.ExecerContext).ExecContext(context.Background(), "UPDATE t SET a = 1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := res.RowsAffected(); n != 2 {
+		t.Errorf("rows affected %d, expected 2", n)
+	}
+
+	rows, err := c.(driver.QueryerContext).QueryContext(context.Background(), "SELECT a FROM t", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dest := make([]driver.Value, 1)
+	if err = rows.Next(dest); err != nil || dest[0] != int64(1) {
+		t.Errorf("next returned %v, %v", dest[0], err)
+	}
+	if rows.(driver.RowsNextResultSet).HasNextResultSet() {
+		t.Error("unexpected next result set")
+	}
+}
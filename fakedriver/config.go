@@ -0,0 +1,107 @@
+package fakedriver
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+type (
+	// Outcome describes result of a call without payload: error that must be returned and latency before returning.
+	// Latency is interrupted if context of the call is done, in this case context error is returned
+	Outcome struct {
+		Err     error
+		Latency time.Duration
+	}
+
+	// ColumnType describes column for RowsColumnType* optional interfaces
+	ColumnType struct {
+		ScanType         reflect.Type
+		DatabaseTypeName string
+		Length           int64
+		Nullable         bool
+		Precision        int64
+		Scale            int64
+	}
+
+	// Response describes result of Exec or Query.
+	// For Query: Columns, ColumnTypes and Rows are returned via [driver.Rows]. If NextErr is not nil it is returned from
+	// Next instead of [io.EOF] after all Rows are consumed. RowLatency precedes every Next call and is interrupted if
+	// context of the query is done. NextResultSets are available via [driver.RowsNextResultSet].
+	// For Exec: LastInsertId and RowsAffected are returned via [driver.Result].
+	// Err is returned instead of rows or result after Latency
+	Response struct {
+		Columns        []string
+		ColumnTypes    []ColumnType
+		Rows           [][]driver.Value
+		RowLatency     time.Duration
+		NextErr        error
+		NextResultSets []Response
+
+		LastInsertId int64
+		RowsAffected int64
+
+		Err     error
+		Latency time.Duration
+	}
+
+	// Rule maps query on Response. Query is matched exactly, Pattern is matched via regexp. If both are empty, any
+	// query is matched. If Args is not nil, argument values must be equal to it as well. If Match is not nil, it must
+	// return true as well. Times limits number of matches of the rule, 0 means unlimited
+	Rule struct {
+		Query    string
+		Pattern  *regexp.Regexp
+		Args     []driver.Value
+		Match    func(query string, args []driver.NamedValue) bool
+		Times    int
+		Response Response
+	}
+
+	// Config describes behaviour of Driver. Rules are matched in order, if none of them matches, Default is used.
+	// Invalid makes [driver.Validator] report connections as invalid. CheckNamedValue is used by
+	// [driver.NamedValueChecker], if it is nil [driver.ErrSkip] is returned
+	Config struct {
+		Features Feature
+
+		Connect      Outcome
+		Close        Outcome
+		Begin        Outcome
+		Commit       Outcome
+		Rollback     Outcome
+		Prepare      Outcome
+		StmtClose    Outcome
+		RowsClose    Outcome
+		Ping         Outcome
+		ResetSession Outcome
+
+		Invalid         bool
+		CheckNamedValue func(value *driver.NamedValue) error
+
+		Rules   []Rule
+		Default Response
+	}
+)
+
+func (r Rule) matches(query string, args []driver.NamedValue) bool {
+	if r.Query != "" && r.Query != query {
+		return false
+	}
+	if r.Pattern != nil && !r.Pattern.MatchString(query) {
+		return false
+	}
+	if r.Args != nil {
+		if len(r.Args) != len(args) {
+			return false
+		}
+		for i := range args {
+			if !reflect.DeepEqual(r.Args[i], args[i].Value) {
+				return false
+			}
+		}
+	}
+	if r.Match != nil && !r.Match(query, args) {
+		return false
+	}
+	return true
+}
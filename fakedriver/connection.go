@@ -0,0 +1,97 @@
+package fakedriver
+
+import (
+	"context"
+	"database/sql/driver"
+)
+
+var (
+	_ driver.Conn = (*conn)(nil)
+)
+
+type (
+	// conn implements only [driver.Conn], optional interfaces are implemented by conn* mixins which are composed
+	// according to Config.Features in composeConn
+	conn struct {
+		d *Driver
+	}
+
+	connBeginTx           struct{ c *conn }
+	connPrepareContext    struct{ c *conn }
+	connExecer            struct{ c *conn }
+	connExecerContext     struct{ c *conn }
+	connQueryer           struct{ c *conn }
+	connQueryerContext    struct{ c *conn }
+	connPinger            struct{ c *conn }
+	connSessionResetter   struct{ c *conn }
+	connValidator         struct{ c *conn }
+	connNamedValueChecker struct{ c *conn }
+)
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.prepare(context.Background(), query)
+}
+
+func (c *conn) Close() error {
+	return c.d.cfg.Close.apply(context.Background())
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.begin(context.Background())
+}
+
+func (c *conn) prepare(ctx context.Context, query string) (driver.Stmt, error) {
+	if err := c.d.cfg.Prepare.apply(ctx); err != nil {
+		return nil, err
+	}
+
+	return composeStmt(&stmt{d: c.d, query: query}, c.d.cfg.Features), nil
+}
+
+func (c *conn) begin(ctx context.Context) (driver.Tx, error) {
+	if err := c.d.cfg.Begin.apply(ctx); err != nil {
+		return nil, err
+	}
+
+	return &transaction{d: c.d, ctx: ctx}, nil
+}
+
+func (m connBeginTx) BeginTx(ctx context.Context, _ driver.TxOptions) (driver.Tx, error) {
+	return m.c.begin(ctx)
+}
+
+func (m connPrepareContext) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return m.c.prepare(ctx, query)
+}
+
+func (m connExecer) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return m.c.d.exec(context.Background(), query, driverValuesToNamed(args))
+}
+
+func (m connExecerContext) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return m.c.d.exec(ctx, query, args)
+}
+
+func (m connQueryer) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return m.c.d.query(context.Background(), query, driverValuesToNamed(args))
+}
+
+func (m connQueryerContext) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return m.c.d.query(ctx, query, args)
+}
+
+func (m connPinger) Ping(ctx context.Context) error {
+	return m.c.d.cfg.Ping.apply(ctx)
+}
+
+func (m connSessionResetter) ResetSession(ctx context.Context) error {
+	return m.c.d.cfg.ResetSession.apply(ctx)
+}
+
+func (m connValidator) IsValid() bool {
+	return !m.c.d.cfg.Invalid
+}
+
+func (m connNamedValueChecker) CheckNamedValue(value *driver.NamedValue) error {
+	return m.c.d.checkNamedValue(value)
+}
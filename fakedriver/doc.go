@@ -0,0 +1,28 @@
+// Package fakedriver provides scriptable implementation of [database/sql/driver] that can be used for testing code
+// built on top of [database/sql] without a real database. Use New to create Driver and pass either Driver itself to
+// [sql.OpenDB] (it implements [driver.Connector]) or register it via [sql.Register].
+//
+// Responses are configured by Config.Rules that are matched against query text in order. Every call without payload
+// (Connect, Begin, Commit, Ping and so on) can be configured to fail or to take some time via Outcome.
+// Support of every optional [database/sql/driver] interface can be toggled via Config.Features. Example:
+//
+//	d := fakedriver.New(fakedriver.Config{
+//		Features: fakedriver.FeatureAll &^ fakedriver.FeatureExecerContext,
+//		Rules: []fakedriver.Rule{
+//			{
+//				Query: "SELECT id, name FROM users",
+//				Response: fakedriver.Response{
+//					Columns: []string{"id", "name"},
+//					Rows:    [][]driver.Value{{int64(1), "John"}, {int64(2), "Jane"}},
+//				},
+//			},
+//			{
+//				Pattern:  regexp.MustCompile(`^DELETE`),
+//				Response: fakedriver.Response{Err: driver.ErrBadConn},
+//			},
+//		},
+//	})
+//	db := sql.OpenDB(d)
+package fakedriver
+
+//go:generate go run gen.go
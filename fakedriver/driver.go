@@ -0,0 +1,124 @@
+package fakedriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync"
+)
+
+var (
+	_ driver.Driver        = (*Driver)(nil)
+	_ driver.DriverContext = (*Driver)(nil)
+	_ driver.Connector     = (*Driver)(nil)
+)
+
+type (
+	// Driver is a scriptable [driver.Driver] and [driver.Connector]. It is safe for concurrent use
+	Driver struct {
+		cfg Config
+
+		mu      sync.Mutex
+		matches []int
+	}
+)
+
+// New returns new Driver configured by cfg
+func New(cfg Config) *Driver {
+	return &Driver{
+		cfg:     cfg,
+		matches: make([]int, len(cfg.Rules)),
+	}
+}
+
+// Open ignores name and returns new connection
+func (d *Driver) Open(_ string) (driver.Conn, error) {
+	return d.Connect(context.Background())
+}
+
+// OpenConnector ignores name and returns Driver itself
+func (d *Driver) OpenConnector(_ string) (driver.Connector, error) {
+	return d, nil
+}
+
+func (d *Driver) Connect(ctx context.Context) (driver.Conn, error) {
+	if err := d.cfg.Connect.apply(ctx); err != nil {
+		return nil, err
+	}
+
+	return composeConn(&conn{d: d}, d.cfg.Features), nil
+}
+
+func (d *Driver) Driver() driver.Driver {
+	return d
+}
+
+// Pending returns rules with limited Times that were not matched enough times yet
+func (d *Driver) Pending() []Rule {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var pending []Rule
+	for i, rule := range d.cfg.Rules {
+		if rule.Times > 0 && d.matches[i] < rule.Times {
+			pending = append(pending, rule)
+		}
+	}
+
+	return pending
+}
+
+func (d *Driver) respond(query string, args []driver.NamedValue) Response {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i, rule := range d.cfg.Rules {
+		if rule.Times > 0 && d.matches[i] >= rule.Times {
+			continue
+		}
+		if !rule.matches(query, args) {
+			continue
+		}
+
+		d.matches[i]++
+		return rule.Response
+	}
+
+	return d.cfg.Default
+}
+
+func (d *Driver) exec(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	resp := d.respond(query, args)
+
+	if err := wait(ctx, resp.Latency); err != nil {
+		return nil, err
+	}
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	return result{
+		lastInsertId: resp.LastInsertId,
+		rowsAffected: resp.RowsAffected,
+	}, nil
+}
+
+func (d *Driver) query(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	resp := d.respond(query, args)
+
+	if err := wait(ctx, resp.Latency); err != nil {
+		return nil, err
+	}
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	return composeRows(&rows{d: d, ctx: ctx, resp: resp, next: resp.NextResultSets}, d.cfg.Features), nil
+}
+
+func (d *Driver) checkNamedValue(value *driver.NamedValue) error {
+	if d.cfg.CheckNamedValue == nil {
+		return driver.ErrSkip
+	}
+
+	return d.cfg.CheckNamedValue(value)
+}
@@ -0,0 +1,80 @@
+package fakedriver
+
+type (
+	// Feature is a bitmask of optional [database/sql/driver] interfaces supported by connections, statements and rows
+	// created by Driver
+	Feature uint32
+)
+
+const (
+	FeatureConnBeginTx Feature = 1 << iota
+	FeatureConnPrepareContext
+	FeatureExecer
+	FeatureExecerContext
+	FeatureQueryer
+	FeatureQueryerContext
+	FeaturePinger
+	FeatureSessionResetter
+	FeatureValidator
+	FeatureConnNamedValueChecker
+
+	FeatureStmtExecContext
+	FeatureStmtQueryContext
+	FeatureStmtNamedValueChecker
+	FeatureColumnConverter
+
+	FeatureRowsNextResultSet
+	FeatureRowsColumnTypeScanType
+	FeatureRowsColumnTypeDatabaseTypeName
+	FeatureRowsColumnTypeLength
+	FeatureRowsColumnTypeNullable
+	FeatureRowsColumnTypePrecisionScale
+
+	featureEnd
+)
+
+const (
+	// FeatureNone disables all optional interfaces, only [driver.Conn], [driver.Stmt], [driver.Rows] and [driver.Tx]
+	// are implemented
+	FeatureNone Feature = 0
+	// FeatureAll enables all optional interfaces
+	FeatureAll = featureEnd - 1
+
+	// FeatureConnAll enables all optional interfaces of [driver.Conn]
+	FeatureConnAll = FeatureConnBeginTx | FeatureConnPrepareContext | FeatureExecer | FeatureExecerContext |
+		FeatureQueryer | FeatureQueryerContext | FeaturePinger | FeatureSessionResetter | FeatureValidator |
+		FeatureConnNamedValueChecker
+	// FeatureStmtAll enables all optional interfaces of [driver.Stmt]
+	FeatureStmtAll = FeatureStmtExecContext | FeatureStmtQueryContext | FeatureStmtNamedValueChecker |
+		FeatureColumnConverter
+	// FeatureRowsAll enables all optional interfaces of [driver.Rows]
+	FeatureRowsAll = FeatureRowsNextResultSet | FeatureRowsColumnTypeScanType |
+		FeatureRowsColumnTypeDatabaseTypeName | FeatureRowsColumnTypeLength | FeatureRowsColumnTypeNullable |
+		FeatureRowsColumnTypePrecisionScale
+)
+
+const (
+	connFeatureShift = 0
+	connFeatureCount = 10
+	stmtFeatureShift = connFeatureShift + connFeatureCount
+	stmtFeatureCount = 4
+	rowsFeatureShift = stmtFeatureShift + stmtFeatureCount
+	rowsFeatureCount = 6
+)
+
+// Has reports whether all features of f2 are enabled in f
+func (f Feature) Has(f2 Feature) bool {
+	return f&f2 == f2
+}
+
+func (f Feature) conn() int {
+	return int(f>>connFeatureShift) & (1<<connFeatureCount - 1)
+}
+
+func (f Feature) stmt() int {
+	return int(f>>stmtFeatureShift) & (1<<stmtFeatureCount - 1)
+}
+
+func (f Feature) rows() int {
+	return int(f>>rowsFeatureShift) & (1<<rowsFeatureCount - 1)
+}
@@ -0,0 +1,114 @@
+//go:build ignore
+
+// gen.go generates compose.go: for every combination of optional interfaces it creates a function returning a value
+// that embeds base type and the mixins of enabled interfaces. Run it via go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+)
+
+type (
+	kind struct {
+		name   string
+		base   string
+		field  string
+		iface  string
+		mixins []string
+	}
+)
+
+var kinds = []kind{
+	{
+		name:  "Conn",
+		base:  "conn",
+		field: "c",
+		iface: "driver.Conn",
+		mixins: []string{
+			"connBeginTx",
+			"connPrepareContext",
+			"connExecer",
+			"connExecerContext",
+			"connQueryer",
+			"connQueryerContext",
+			"connPinger",
+			"connSessionResetter",
+			"connValidator",
+			"connNamedValueChecker",
+		},
+	},
+	{
+		name:  "Stmt",
+		base:  "stmt",
+		field: "s",
+		iface: "driver.Stmt",
+		mixins: []string{
+			"stmtExecContext",
+			"stmtQueryContext",
+			"stmtNamedValueChecker",
+			"stmtColumnConverter",
+		},
+	},
+	{
+		name:  "Rows",
+		base:  "rows",
+		field: "r",
+		iface: "driver.Rows",
+		mixins: []string{
+			"rowsNextResultSet",
+			"rowsColumnTypeScanType",
+			"rowsColumnTypeDatabaseTypeName",
+			"rowsColumnTypeLength",
+			"rowsColumnTypeNullable",
+			"rowsColumnTypePrecisionScale",
+		},
+	},
+}
+
+func main() {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package fakedriver\n\n")
+	buf.WriteString("import \"database/sql/driver\"\n\n")
+
+	for _, k := range kinds {
+		fmt.Fprintf(&buf, "func compose%s(%s *%s, f Feature) %s {\n", k.name, k.field, k.base, k.iface)
+		fmt.Fprintf(&buf, "\treturn %sCompositions[f.%s()](%s)\n", k.base, k.base, k.field)
+		buf.WriteString("}\n\n")
+
+		fmt.Fprintf(&buf, "var %sCompositions = [...]func(%s *%s) %s{\n", k.base, k.field, k.base, k.iface)
+		for mask := 0; mask < 1<<len(k.mixins); mask++ {
+			types := []string{"*" + k.base}
+			values := []string{k.field}
+			for i, mixin := range k.mixins {
+				if mask&(1<<i) != 0 {
+					types = append(types, mixin)
+					values = append(values, mixin+"{"+k.field+"}")
+				}
+			}
+
+			fmt.Fprintf(&buf, "\t%d: func(%s *%s) %s { ", mask, k.field, k.base, k.iface)
+			if mask == 0 {
+				fmt.Fprintf(&buf, "return %s },\n", k.field)
+				continue
+			}
+			fmt.Fprintf(&buf, "return struct{ %s }{%s} },\n", strings.Join(types, "; "), strings.Join(values, ", "))
+		}
+		buf.WriteString("}\n\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	err = os.WriteFile("compose.go", src, 0o644)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,107 @@
+package fakedriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"reflect"
+)
+
+var (
+	_ driver.Rows = (*rows)(nil)
+)
+
+type (
+	// rows implements only [driver.Rows], optional interfaces are implemented by rows* mixins which are composed
+	// according to Config.Features in composeRows
+	rows struct {
+		d *Driver
+		// ctx is a context of the query, RowLatency is interrupted when it is done
+		ctx  context.Context
+		resp Response
+		next []Response
+		pos  int
+	}
+
+	rowsNextResultSet              struct{ r *rows }
+	rowsColumnTypeScanType         struct{ r *rows }
+	rowsColumnTypeDatabaseTypeName struct{ r *rows }
+	rowsColumnTypeLength           struct{ r *rows }
+	rowsColumnTypeNullable         struct{ r *rows }
+	rowsColumnTypePrecisionScale   struct{ r *rows }
+)
+
+func (r *rows) Columns() []string {
+	return r.resp.Columns
+}
+
+func (r *rows) Close() error {
+	return r.d.cfg.RowsClose.apply(context.Background())
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if err := wait(r.ctx, r.resp.RowLatency); err != nil {
+		return err
+	}
+
+	if r.pos >= len(r.resp.Rows) {
+		if r.resp.NextErr != nil {
+			return r.resp.NextErr
+		}
+		return io.EOF
+	}
+
+	copy(dest, r.resp.Rows[r.pos])
+	r.pos++
+
+	return nil
+}
+
+func (r *rows) columnType(index int) ColumnType {
+	if index < 0 || index >= len(r.resp.ColumnTypes) {
+		return ColumnType{}
+	}
+
+	return r.resp.ColumnTypes[index]
+}
+
+func (m rowsNextResultSet) HasNextResultSet() bool {
+	return len(m.r.next) > 0
+}
+
+func (m rowsNextResultSet) NextResultSet() error {
+	if len(m.r.next) == 0 {
+		return io.EOF
+	}
+
+	m.r.resp, m.r.next, m.r.pos = m.r.next[0], m.r.next[1:], 0
+
+	return nil
+}
+
+func (m rowsColumnTypeScanType) ColumnTypeScanType(index int) reflect.Type {
+	scanType := m.r.columnType(index).ScanType
+	if scanType == nil {
+		return reflect.TypeFor[any]()
+	}
+
+	return scanType
+}
+
+func (m rowsColumnTypeDatabaseTypeName) ColumnTypeDatabaseTypeName(index int) string {
+	return m.r.columnType(index).DatabaseTypeName
+}
+
+func (m rowsColumnTypeLength) ColumnTypeLength(index int) (int64, bool) {
+	ct := m.r.columnType(index)
+	return ct.Length, ct.Length > 0
+}
+
+func (m rowsColumnTypeNullable) ColumnTypeNullable(index int) (bool, bool) {
+	return m.r.columnType(index).Nullable, true
+}
+
+func (m rowsColumnTypePrecisionScale) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	ct := m.r.columnType(index)
+	return ct.Precision, ct.Scale, ct.Precision > 0
+}
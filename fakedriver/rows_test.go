@@ -0,0 +1,32 @@
+package fakedriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRowLatencyCanceled(t *testing.T) {
+	d := New(Config{
+		Default: Response{
+			Columns:    []string{"a"},
+			Rows:       [][]driver.Value{{int64(1)}},
+			RowLatency: time.Hour,
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rows, err := d.query(ctx, "SELECT a FROM t", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	err = rows.Next(make([]driver.Value, 1))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
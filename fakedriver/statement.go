@@ -0,0 +1,57 @@
+package fakedriver
+
+import (
+	"context"
+	"database/sql/driver"
+)
+
+var (
+	_ driver.Stmt = (*stmt)(nil)
+)
+
+type (
+	// stmt implements only [driver.Stmt], optional interfaces are implemented by stmt* mixins which are composed
+	// according to Config.Features in composeStmt
+	stmt struct {
+		d     *Driver
+		query string
+	}
+
+	stmtExecContext       struct{ s *stmt }
+	stmtQueryContext      struct{ s *stmt }
+	stmtNamedValueChecker struct{ s *stmt }
+	stmtColumnConverter   struct{ s *stmt }
+)
+
+func (s *stmt) Close() error {
+	return s.d.cfg.StmtClose.apply(context.Background())
+}
+
+// NumInput returns -1 so that [database/sql] does not check number of arguments
+func (s *stmt) NumInput() int {
+	return -1
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.d.exec(context.Background(), s.query, driverValuesToNamed(args))
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.d.query(context.Background(), s.query, driverValuesToNamed(args))
+}
+
+func (m stmtExecContext) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return m.s.d.exec(ctx, m.s.query, args)
+}
+
+func (m stmtQueryContext) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return m.s.d.query(ctx, m.s.query, args)
+}
+
+func (m stmtNamedValueChecker) CheckNamedValue(value *driver.NamedValue) error {
+	return m.s.d.checkNamedValue(value)
+}
+
+func (m stmtColumnConverter) ColumnConverter(_ int) driver.ValueConverter {
+	return driver.DefaultParameterConverter
+}
@@ -0,0 +1,25 @@
+package fakedriver
+
+import (
+	"context"
+	"database/sql/driver"
+)
+
+var (
+	_ driver.Tx = (*transaction)(nil)
+)
+
+type (
+	transaction struct {
+		d   *Driver
+		ctx context.Context
+	}
+)
+
+func (t *transaction) Commit() error {
+	return t.d.cfg.Commit.apply(t.ctx)
+}
+
+func (t *transaction) Rollback() error {
+	return t.d.cfg.Rollback.apply(t.ctx)
+}
@@ -0,0 +1,42 @@
+package fakedriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"time"
+)
+
+func wait(ctx context.Context, dt time.Duration) error {
+	if dt <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(dt)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (o Outcome) apply(ctx context.Context) error {
+	if err := wait(ctx, o.Latency); err != nil {
+		return err
+	}
+
+	return o.Err
+}
+
+func driverValuesToNamed(args []driver.Value) []driver.NamedValue {
+	result := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		result[i] = driver.NamedValue{
+			Ordinal: i + 1,
+			Value:   arg,
+		}
+	}
+	return result
+}
@@ -0,0 +1,25 @@
+// Package audit provides [logsql.Logger] that keeps a tamper-evident audit trail of data-modifying statements.
+//
+// Logger records INSERT, UPDATE, DELETE, MERGE and DDL statements along with redacted arguments, rows affected, user
+// and transaction ID, and outcomes of transactions that contain such statements. Records are appended to a JSON Lines
+// file, each record contains hash of the previous one, so modification, removal or reordering of records is detected
+// by Verify:
+//
+//	al, err := audit.Open("audit.jsonl", audit.Config{Next: logger})
+//	db := sql.OpenDB(logsql.NewConnectorFromConnector(connector, logsql.Config{
+//		LogHandler: al,
+//	}))
+//	// ... run the code
+//	ctx = audit.WithUser(ctx, "john")
+//	_, err = db.ExecContext(ctx, `UPDATE users SET name = $1 WHERE id = $2`, "John", 1)
+//	// ...
+//	last, err := audit.VerifyFile("audit.jsonl")
+//
+// Removal of records from the end of file can only be detected by comparing the last record returned by Verify
+// with a copy of its hash stored elsewhere.
+//
+// If process crashed while a record was written, the last line of file is partial and Open fails with ErrTruncated.
+// Such file can be fixed by Repair, which removes the partial record only if all preceding ones are intact:
+//
+//	last, err := audit.Repair("audit.jsonl")
+package audit
@@ -0,0 +1,30 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrTampered = errors.New("audit record was tampered")
+	ErrGap      = errors.New("audit records are missing")
+	// ErrTruncated is returned for the last record if it is not terminated by new line, which happens if process
+	// crashed while the record was written. See Repair
+	ErrTruncated = errors.New("audit record is truncated")
+)
+
+type (
+	// VerifyError is returned by Verify for the first invalid record. Line is 1-based
+	VerifyError struct {
+		Line int
+		Err  error
+	}
+)
+
+func (e *VerifyError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+func (e *VerifyError) Unwrap() error {
+	return e.Err
+}
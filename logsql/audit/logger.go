@@ -0,0 +1,447 @@
+package audit
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+var (
+	_ logsql.Logger            = (*Logger)(nil)
+	_ logsql.SessionLogger     = (*Logger)(nil)
+	_ logsql.ResultSetLogger   = (*Logger)(nil)
+	_ logsql.RetryLogger       = (*Logger)(nil)
+	_ logsql.TxRunnerLogger    = (*Logger)(nil)
+	_ logsql.BreakerLogger     = (*Logger)(nil)
+	_ logsql.TimeoutLogger     = (*Logger)(nil)
+	_ logsql.LimiterLogger     = (*Logger)(nil)
+	_ logsql.PolicyLogger      = (*Logger)(nil)
+	_ logsql.ReplicaLogger     = (*Logger)(nil)
+	_ logsql.FailoverLogger    = (*Logger)(nil)
+	_ logsql.StmtCacheLogger   = (*Logger)(nil)
+	_ logsql.ResultCacheLogger = (*Logger)(nil)
+	_ logsql.BudgetLogger      = (*Logger)(nil)
+	_ logsql.ExplainLogger     = (*Logger)(nil)
+	_ logsql.ExecResultLogger  = (*Logger)(nil)
+)
+
+const (
+	ctxKeyUser ctxKey = iota
+)
+
+type (
+	// Config of Logger. Events are forwarded to Next if it is not nil, events of optional interfaces are forwarded
+	// if Next implements them. User returns user that is recorded, if it is nil UserFromContext is used. Redact
+	// converts every argument before it is recorded, if it is nil DefaultRedact is used.
+	// If Sync is true, file is synced after every record. OnError is called if record could not be written
+	Config struct {
+		Next    logsql.Logger
+		User    func(ctx context.Context) string
+		Redact  func(arg driver.NamedValue) any
+		Sync    bool
+		OnError func(err error)
+	}
+
+	// Logger is a [logsql.Logger] that appends audit records to a file. It is safe for concurrent use
+	Logger struct {
+		cfg Config
+
+		mu   sync.Mutex
+		f    *os.File
+		last Record
+		// txs contains IDs of transactions with recorded statements which outcome is not recorded yet
+		txs map[uint64]struct{}
+	}
+
+	// ctxKey is a type of context keys of the package
+	ctxKey uint8
+)
+
+// Open opens audit file at path for appending, file is created if it does not exist. Existing records are verified
+// and the chain is continued from the last one. If process crashed while a record was written, Open fails with
+// ErrTruncated until the partial record is removed by Repair
+func Open(path string, cfg Config) (*Logger, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	last, err := Verify(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("verify %s: %w", path, err)
+	}
+
+	if cfg.User == nil {
+		cfg.User = UserFromContext
+	}
+	if cfg.Redact == nil {
+		cfg.Redact = DefaultRedact
+	}
+
+	return &Logger{
+		cfg:  cfg,
+		f:    f,
+		last: last,
+		txs:  make(map[uint64]struct{}),
+	}, nil
+}
+
+// WithUser returns context that makes Logger record user for statements executed with it
+func WithUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, ctxKeyUser, user)
+}
+
+// UserFromContext returns user set by WithUser, empty string if it is not set
+func UserFromContext(ctx context.Context) string {
+	user, _ := ctx.Value(ctxKeyUser).(string)
+	return user
+}
+
+// Close closes audit file
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.f.Close()
+}
+
+// Last returns the last written record. Its hash can be stored elsewhere to detect removal of records from the end
+// of file
+func (l *Logger) Last() Record {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.last
+}
+
+func (l *Logger) Connect(ctx context.Context, err error, dt time.Duration) {
+	if l.cfg.Next != nil {
+		l.cfg.Next.Connect(ctx, err, dt)
+	}
+}
+
+func (l *Logger) ConnClose(ctx context.Context, err error, dt time.Duration) {
+	if l.cfg.Next != nil {
+		l.cfg.Next.ConnClose(ctx, err, dt)
+	}
+}
+
+func (l *Logger) TxBegin(ctx context.Context, err error, dt time.Duration) {
+	if l.cfg.Next != nil {
+		l.cfg.Next.TxBegin(ctx, err, dt)
+	}
+}
+
+func (l *Logger) TxCommit(ctx context.Context, err error, dt time.Duration) {
+	l.recordTx(ctx, EventCommit, err)
+	if l.cfg.Next != nil {
+		l.cfg.Next.TxCommit(ctx, err, dt)
+	}
+}
+
+func (l *Logger) TxRollback(ctx context.Context, err error, dt time.Duration) {
+	l.recordTx(ctx, EventRollback, err)
+	if l.cfg.Next != nil {
+		l.cfg.Next.TxRollback(ctx, err, dt)
+	}
+}
+
+func (l *Logger) Exec(ctx context.Context, query string, args []driver.NamedValue, replacedErr error, err error,
+	dt time.Duration) {
+	// Successful statements are recorded by ExecResult along with rows affected, fallbacks of [database/sql] after
+	// [driver.ErrSkip] are recorded by the call they fall back to
+	if err != nil && !errors.Is(err, driver.ErrSkip) {
+		l.recordStatement(ctx, EventExec, query, args, nil, err)
+	}
+	if l.cfg.Next != nil {
+		l.cfg.Next.Exec(ctx, query, args, replacedErr, err, dt)
+	}
+}
+
+func (l *Logger) Query(ctx context.Context, query string, args []driver.NamedValue, replacedErr error, err error,
+	dt time.Duration) {
+	if !errors.Is(err, driver.ErrSkip) {
+		l.recordStatement(ctx, EventQuery, query, args, nil, err)
+	}
+	if l.cfg.Next != nil {
+		l.cfg.Next.Query(ctx, query, args, replacedErr, err, dt)
+	}
+}
+
+func (l *Logger) Ping(ctx context.Context, err error, dt time.Duration) {
+	if l.cfg.Next != nil {
+		l.cfg.Next.Ping(ctx, err, dt)
+	}
+}
+
+func (l *Logger) RowsClose(ctx context.Context, err error, dt time.Duration) {
+	if l.cfg.Next != nil {
+		l.cfg.Next.RowsClose(ctx, err, dt)
+	}
+}
+
+func (l *Logger) RowsNext(ctx context.Context, dest []driver.Value, err error, dt time.Duration) {
+	if l.cfg.Next != nil {
+		l.cfg.Next.RowsNext(ctx, dest, err, dt)
+	}
+}
+
+func (l *Logger) PrepareStatement(ctx context.Context, query string, err error, dt time.Duration) {
+	if l.cfg.Next != nil {
+		l.cfg.Next.PrepareStatement(ctx, query, err, dt)
+	}
+}
+
+func (l *Logger) ClosePreparedStatement(ctx context.Context, query string, err error, dt time.Duration) {
+	if l.cfg.Next != nil {
+		l.cfg.Next.ClosePreparedStatement(ctx, query, err, dt)
+	}
+}
+
+func (l *Logger) ExecPreparedStatement(ctx context.Context, query string, args []driver.NamedValue, replacedErr error,
+	err error, dt time.Duration) {
+	if err != nil && !errors.Is(err, driver.ErrSkip) {
+		l.recordStatement(ctx, EventExec, query, args, nil, err)
+	}
+	if l.cfg.Next != nil {
+		l.cfg.Next.ExecPreparedStatement(ctx, query, args, replacedErr, err, dt)
+	}
+}
+
+func (l *Logger) QueryPreparedStatement(ctx context.Context, query string, args []driver.NamedValue,
+	replacedErr error, err error, dt time.Duration) {
+	if !errors.Is(err, driver.ErrSkip) {
+		l.recordStatement(ctx, EventQuery, query, args, nil, err)
+	}
+	if l.cfg.Next != nil {
+		l.cfg.Next.QueryPreparedStatement(ctx, query, args, replacedErr, err, dt)
+	}
+}
+
+func (l *Logger) ExecResult(ctx context.Context, query string, args []driver.NamedValue, rowsAffected int64,
+	err error) {
+	// Statement succeeded, err only means that rows affected are unknown
+	var affected *int64
+	if err == nil {
+		affected = &rowsAffected
+	}
+	l.recordStatement(ctx, EventExec, query, args, affected, nil)
+	if erl, ok := l.cfg.Next.(logsql.ExecResultLogger); ok {
+		erl.ExecResult(ctx, query, args, rowsAffected, err)
+	}
+}
+
+func (l *Logger) ResetSession(ctx context.Context, err error, dt time.Duration) {
+	if sl, ok := l.cfg.Next.(logsql.SessionLogger); ok {
+		sl.ResetSession(ctx, err, dt)
+	}
+}
+
+func (l *Logger) ConnInvalidated(ctx context.Context) {
+	if sl, ok := l.cfg.Next.(logsql.SessionLogger); ok {
+		sl.ConnInvalidated(ctx)
+	}
+}
+
+func (l *Logger) CheckNamedValue(ctx context.Context, value driver.NamedValue, err error) {
+	if sl, ok := l.cfg.Next.(logsql.SessionLogger); ok {
+		sl.CheckNamedValue(ctx, value, err)
+	}
+}
+
+func (l *Logger) RowsNextResultSet(ctx context.Context, err error, dt time.Duration) {
+	if rl, ok := l.cfg.Next.(logsql.ResultSetLogger); ok {
+		rl.RowsNextResultSet(ctx, err, dt)
+	}
+}
+
+func (l *Logger) Retry(ctx context.Context, query string, attempt int, err error, backoff time.Duration) {
+	if rl, ok := l.cfg.Next.(logsql.RetryLogger); ok {
+		rl.Retry(ctx, query, attempt, err, backoff)
+	}
+}
+
+func (l *Logger) TxRetry(ctx context.Context, attempt int, err error, backoff time.Duration) {
+	if tl, ok := l.cfg.Next.(logsql.TxRunnerLogger); ok {
+		tl.TxRetry(ctx, attempt, err, backoff)
+	}
+}
+
+func (l *Logger) Savepoint(ctx context.Context, name string, err error, dt time.Duration) {
+	if tl, ok := l.cfg.Next.(logsql.TxRunnerLogger); ok {
+		tl.Savepoint(ctx, name, err, dt)
+	}
+}
+
+func (l *Logger) ReleaseSavepoint(ctx context.Context, name string, err error, dt time.Duration) {
+	if tl, ok := l.cfg.Next.(logsql.TxRunnerLogger); ok {
+		tl.ReleaseSavepoint(ctx, name, err, dt)
+	}
+}
+
+func (l *Logger) RollbackToSavepoint(ctx context.Context, name string, err error, dt time.Duration) {
+	if tl, ok := l.cfg.Next.(logsql.TxRunnerLogger); ok {
+		tl.RollbackToSavepoint(ctx, name, err, dt)
+	}
+}
+
+func (l *Logger) BreakerStateChange(ctx context.Context, from logsql.BreakerState, to logsql.BreakerState, err error) {
+	if bl, ok := l.cfg.Next.(logsql.BreakerLogger); ok {
+		bl.BreakerStateChange(ctx, from, to, err)
+	}
+}
+
+func (l *Logger) Timeout(ctx context.Context, op logsql.Op, query string, timeout time.Duration, err error) {
+	if tl, ok := l.cfg.Next.(logsql.TimeoutLogger); ok {
+		tl.Timeout(ctx, op, query, timeout, err)
+	}
+}
+
+func (l *Logger) LimiterWait(ctx context.Context, query string, class string, wait time.Duration, err error) {
+	if ll, ok := l.cfg.Next.(logsql.LimiterLogger); ok {
+		ll.LimiterWait(ctx, query, class, wait, err)
+	}
+}
+
+func (l *Logger) PolicyViolation(ctx context.Context, query string, violation logsql.PolicyViolation,
+	reportOnly bool) {
+	if pl, ok := l.cfg.Next.(logsql.PolicyLogger); ok {
+		pl.PolicyViolation(ctx, query, violation, reportOnly)
+	}
+}
+
+func (l *Logger) ReplicaHealth(ctx context.Context, target string, healthy bool, err error) {
+	if rl, ok := l.cfg.Next.(logsql.ReplicaLogger); ok {
+		rl.ReplicaHealth(ctx, target, healthy, err)
+	}
+}
+
+func (l *Logger) HostDown(ctx context.Context, host string, err error, backoff time.Duration) {
+	if fl, ok := l.cfg.Next.(logsql.FailoverLogger); ok {
+		fl.HostDown(ctx, host, err, backoff)
+	}
+}
+
+func (l *Logger) Failover(ctx context.Context, from string, to string) {
+	if fl, ok := l.cfg.Next.(logsql.FailoverLogger); ok {
+		fl.Failover(ctx, from, to)
+	}
+}
+
+func (l *Logger) StmtCacheLookup(ctx context.Context, query string, hit bool, stats logsql.StmtCacheStats) {
+	if sl, ok := l.cfg.Next.(logsql.StmtCacheLogger); ok {
+		sl.StmtCacheLookup(ctx, query, hit, stats)
+	}
+}
+
+func (l *Logger) ResultCacheLookup(ctx context.Context, query string, args []driver.NamedValue, hit bool) {
+	if rl, ok := l.cfg.Next.(logsql.ResultCacheLogger); ok {
+		rl.ResultCacheLookup(ctx, query, args, hit)
+	}
+}
+
+func (l *Logger) BudgetExceeded(ctx context.Context, query string, usage logsql.BudgetUsage) {
+	if bl, ok := l.cfg.Next.(logsql.BudgetLogger); ok {
+		bl.BudgetExceeded(ctx, query, usage)
+	}
+}
+
+func (l *Logger) NPlusOne(ctx context.Context, fingerprint string, count int, callers []string) {
+	if bl, ok := l.cfg.Next.(logsql.BudgetLogger); ok {
+		bl.NPlusOne(ctx, fingerprint, count, callers)
+	}
+}
+
+func (l *Logger) SlowQuery(ctx context.Context, query string, args []driver.NamedValue, dt time.Duration, plan string,
+	err error) {
+	if el, ok := l.cfg.Next.(logsql.ExplainLogger); ok {
+		el.SlowQuery(ctx, query, args, dt, plan, err)
+	}
+}
+
+// recordStatement writes record of query if it modifies data
+func (l *Logger) recordStatement(ctx context.Context, event, query string, args []driver.NamedValue,
+	rowsAffected *int64, err error) {
+	if !audited(query) {
+		return
+	}
+
+	rec := Record{
+		Event:        event,
+		Query:        query,
+		Args:         make([]any, 0, len(args)),
+		RowsAffected: rowsAffected,
+		User:         l.cfg.User(ctx),
+	}
+	for _, arg := range args {
+		rec.Args = append(rec.Args, l.cfg.Redact(arg))
+	}
+	if err != nil {
+		rec.Err, rec.ErrClass = err.Error(), logsql.ClassifyErr(ctx, err).String()
+	}
+	rec.TxID, _ = logsql.TxIDFromContext(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.write(rec) && rec.TxID != 0 {
+		l.txs[rec.TxID] = struct{}{}
+	}
+}
+
+// recordTx writes outcome of transaction if it contains recorded statements
+func (l *Logger) recordTx(ctx context.Context, event string, err error) {
+	txID, ok := logsql.TxIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok = l.txs[txID]; !ok {
+		return
+	}
+	delete(l.txs, txID)
+
+	rec := Record{
+		Event: event,
+		TxID:  txID,
+	}
+	if err != nil {
+		rec.Err, rec.ErrClass = err.Error(), logsql.ClassifyErr(ctx, err).String()
+	}
+	l.write(rec)
+}
+
+// write chains rec to the last record and appends it to file. Mutex must be held by caller
+func (l *Logger) write(rec Record) bool {
+	rec.Seq = l.last.Seq + 1
+	rec.Time = time.Now().UTC()
+	rec.PrevHash = l.last.Hash
+
+	line, err := rec.marshal()
+	if err == nil {
+		_, err = l.f.Write(line)
+	}
+	if err == nil && l.cfg.Sync {
+		err = l.f.Sync()
+	}
+	if err != nil {
+		if l.cfg.OnError != nil {
+			l.cfg.OnError(err)
+		}
+		return false
+	}
+
+	l.last = rec
+
+	return true
+}
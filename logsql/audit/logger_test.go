@@ -0,0 +1,298 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+type (
+	// retryLogger counts retries forwarded by Logger
+	retryLogger struct {
+		logsql.Logger
+		retries int
+	}
+)
+
+func (l *retryLogger) Retry(context.Context, string, int, error, time.Duration) {
+	l.retries++
+}
+
+func TestLoggerSkipFallback(t *testing.T) {
+	l, err := Open(filepath.Join(t.TempDir(), "audit.jsonl"), Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer l.Close()
+
+	ctx := context.Background()
+	l.Exec(ctx, "UPDATE t SET a = 1", nil, driver.ErrSkip, driver.ErrSkip, 0)
+	l.Query(ctx, "UPDATE t SET a = 1 RETURNING a", nil, driver.ErrSkip, driver.ErrSkip, 0)
+	l.ExecPreparedStatement(ctx, "UPDATE t SET a = 1", nil, driver.ErrSkip, driver.ErrSkip, 0)
+	l.QueryPreparedStatement(ctx, "UPDATE t SET a = 1 RETURNING a", nil, driver.ErrSkip, driver.ErrSkip, 0)
+
+	if last := l.Last(); last.Seq != 0 {
+		t.Errorf("expected fallbacks not to be recorded, got %+v", last)
+	}
+}
+
+func TestLoggerForwardsOptionalEvents(t *testing.T) {
+	next := &retryLogger{}
+	l, err := Open(filepath.Join(t.TempDir(), "audit.jsonl"), Config{Next: next})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer l.Close()
+
+	l.Retry(context.Background(), "UPDATE t SET a = 1", 1, nil, 0)
+	l.BreakerStateChange(context.Background(), logsql.BreakerClosed, logsql.BreakerOpen, nil)
+
+	if next.retries != 1 {
+		t.Errorf("expected retry to be forwarded, got %d", next.retries)
+	}
+}
+
+// openDB opens audit file at path and database that records statements into it
+func openDB(t *testing.T, path string) (*sql.DB, *Logger) {
+	t.Helper()
+
+	l, err := Open(path, Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Default:  fakedriver.Response{RowsAffected: 1},
+	})
+
+	return sql.OpenDB(logsql.NewConnectorFromConnector(d, logsql.Config{LogHandler: l})), l
+}
+
+// readLines returns lines of file at path with trailing new lines
+func readLines(t *testing.T, path string) [][]byte {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := bytes.SplitAfter(data, []byte("\n"))
+
+	return lines[:len(lines)-1]
+}
+
+func TestAuditTrail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	db, l := openDB(t, path)
+	defer l.Close()
+	defer db.Close()
+
+	ctx := WithUser(context.Background(), "john")
+	if _, err := db.ExecContext(ctx, "UPDATE t SET a = $1", "secret"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	steps := []struct {
+		query  string
+		commit bool
+	}{
+		{query: "UPDATE t SET a = 1", commit: true},
+		{query: "SELECT a FROM t", commit: true},
+		{query: "SELECT a FROM t", commit: false},
+		{query: "DELETE FROM t", commit: false},
+	}
+	for _, step := range steps {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err = tx.ExecContext(ctx, step.query); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if step.commit {
+			err = tx.Commit()
+		} else {
+			err = tx.Rollback()
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	last, err := VerifyFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if last.Hash != l.Last().Hash {
+		t.Errorf("expected last record %+v, got %+v", l.Last(), last)
+	}
+
+	var events []string
+	for _, line := range readLines(t, path) {
+		var rec Record
+		if err = rec.unmarshal(bytes.TrimSuffix(line, []byte("\n"))); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		events = append(events, rec.Event+" "+rec.Query)
+
+		if rec.Seq == 1 && (rec.User != "john" || !slices.Equal(rec.Args, []any{"[redacted]"}) ||
+			rec.RowsAffected == nil || *rec.RowsAffected != 1) {
+			t.Errorf("unexpected record %+v", rec)
+		}
+	}
+
+	expected := []string{"exec UPDATE t SET a = $1", "exec UPDATE t SET a = 1", "commit ", "exec DELETE FROM t",
+		"rollback "}
+	if !slices.Equal(events, expected) {
+		t.Errorf("expected records %q, got %q", expected, events)
+	}
+}
+
+func TestAuditReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	for i := range 2 {
+		db, l := openDB(t, path)
+		if _, err := db.Exec("UPDATE t SET a = 1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if last := l.Last(); last.Seq != uint64(i+1) {
+			t.Errorf("expected chain to be continued, got seq %d", last.Seq)
+		}
+		_ = db.Close()
+		_ = l.Close()
+	}
+
+	last, err := VerifyFile(path)
+	if err != nil || last.Seq != 2 {
+		t.Errorf("expected 2 intact records, got %d, %v", last.Seq, err)
+	}
+}
+
+func TestAuditTampering(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	db, l := openDB(t, path)
+	for _, query := range []string{"UPDATE t SET a = 1", "UPDATE t SET a = 2", "UPDATE t SET a = 3"} {
+		if _, err := db.Exec(query); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	_ = db.Close()
+	_ = l.Close()
+
+	lines := readLines(t, path)
+
+	tests := []struct {
+		name  string
+		lines [][]byte
+		line  int
+		err   error
+	}{
+		{
+			name: "edited field",
+			lines: [][]byte{lines[0], bytes.Replace(lines[1], []byte("a = 2"), []byte("a = 4"), 1),
+				lines[2]},
+			line: 2,
+			err:  ErrTampered,
+		},
+		{
+			name:  "deleted line",
+			lines: [][]byte{lines[0], lines[2]},
+			line:  2,
+			err:   ErrGap,
+		},
+		{
+			name:  "swapped lines",
+			lines: [][]byte{lines[0], lines[2], lines[1]},
+			line:  2,
+			err:   ErrGap,
+		},
+		{
+			name:  "truncated line",
+			lines: [][]byte{lines[0], lines[1], lines[2][:len(lines[2])/2]},
+			line:  3,
+			err:   ErrTruncated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(bytes.NewReader(bytes.Join(tt.lines, nil)))
+
+			var verr *VerifyError
+			if !errors.As(err, &verr) || verr.Line != tt.line || !errors.Is(err, tt.err) {
+				t.Errorf("expected %v at line %d, got %v", tt.err, tt.line, err)
+			}
+		})
+	}
+}
+
+func TestAuditRepair(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	db, l := openDB(t, path)
+	for _, query := range []string{"UPDATE t SET a = 1", "UPDATE t SET a = 2"} {
+		if _, err := db.Exec(query); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	_ = db.Close()
+	_ = l.Close()
+
+	lines := readLines(t, path)
+	if err := os.WriteFile(path, append(lines[0], lines[1][:len(lines[1])-10]...), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := Open(path, Config{}); !errors.Is(err, ErrTruncated) {
+		t.Fatalf("expected %v, got %v", ErrTruncated, err)
+	}
+
+	last, err := Repair(path)
+	if err != nil || last.Seq != 1 {
+		t.Fatalf("expected record 1 to be the last intact one, got %d, %v", last.Seq, err)
+	}
+
+	db, l = openDB(t, path)
+	defer l.Close()
+	defer db.Close()
+
+	if _, err = db.Exec("UPDATE t SET a = 3"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if last, err = VerifyFile(path); err != nil || last.Seq != 2 {
+		t.Errorf("expected chain to be continued after repair, got %d, %v", last.Seq, err)
+	}
+}
+
+func TestAuditRepairTampered(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	db, l := openDB(t, path)
+	if _, err := db.Exec("UPDATE t SET a = 1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = db.Close()
+	_ = l.Close()
+
+	data := bytes.Replace(readLines(t, path)[0], []byte("a = 1"), []byte("a = 2"), 1)
+	if err := os.WriteFile(path, append(data, `{"seq":2`...), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := Repair(path); !errors.Is(err, ErrTampered) {
+		t.Errorf("expected %v, got %v", ErrTampered, err)
+	}
+	if after, _ := os.ReadFile(path); !bytes.HasSuffix(after, []byte(`{"seq":2`)) {
+		t.Errorf("expected tampered file not to be modified")
+	}
+}
@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+const (
+	EventExec     = "exec"
+	EventQuery    = "query"
+	EventCommit   = "commit"
+	EventRollback = "rollback"
+)
+
+var (
+	// auditedKeywords are leading keywords of statements that are recorded
+	auditedKeywords = []string{"insert", "update", "delete", "merge", "replace", "upsert", "copy",
+		"create", "alter", "drop", "truncate", "rename", "comment", "grant", "revoke"}
+
+	// hashSuffix precedes hash that closes every record line
+	hashSuffix = []byte(`,"hash":"`)
+)
+
+type (
+	// Record is a single line of audit file. Seq starts with 1 and is incremented by every record. Hash is SHA-256 of
+	// the record line without hash field, PrevHash is Hash of the previous record, empty for the first one.
+	// RowsAffected is nil if it is unknown. ErrClass is a class of Err, see [logsql.ErrClass]. Commit and rollback
+	// records contain only TxID, Err and ErrClass
+	Record struct {
+		Seq          uint64    `json:"seq"`
+		Time         time.Time `json:"time"`
+		Event        string    `json:"event"`
+		Query        string    `json:"query,omitempty"`
+		Args         []any     `json:"args,omitempty"`
+		RowsAffected *int64    `json:"rows_affected,omitempty"`
+		User         string    `json:"user,omitempty"`
+		TxID         uint64    `json:"tx_id,omitempty"`
+		Err          string    `json:"err,omitempty"`
+		ErrClass     string    `json:"err_class,omitempty"`
+		PrevHash     string    `json:"prev_hash"`
+		Hash         string    `json:"hash,omitempty"`
+	}
+)
+
+// DefaultRedact keeps nil, boolean, numeric and time values of arguments and replaces others with "[redacted]"
+func DefaultRedact(arg driver.NamedValue) any {
+	switch arg.Value.(type) {
+	case nil, bool, int64, float64, time.Time:
+		return arg.Value
+	default:
+		return "[redacted]"
+	}
+}
+
+// audited reports whether query contains data-modifying statements
+func audited(query string) bool {
+	return slices.ContainsFunc(logsql.StatementKeywords(query), func(keyword string) bool {
+		return slices.Contains(auditedKeywords, keyword)
+	})
+}
+
+// marshal fills Hash of the record and returns line of it terminated by new line
+func (r *Record) marshal() ([]byte, error) {
+	r.Hash = ""
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(body)
+	r.Hash = hex.EncodeToString(sum[:])
+
+	line := make([]byte, 0, len(body)+len(hashSuffix)+len(r.Hash)+3)
+	line = append(line, body[:len(body)-1]...)
+	line = append(line, hashSuffix...)
+	line = append(line, r.Hash...)
+	line = append(line, "\"}\n"...)
+
+	return line, nil
+}
+
+// unmarshal parses line without trailing new line and checks that hash of the record matches its content
+func (r *Record) unmarshal(line []byte) error {
+	i := bytes.LastIndex(line, hashSuffix)
+	if i < 0 || !bytes.HasSuffix(line, []byte(`"}`)) {
+		return fmt.Errorf("%w: no hash", ErrTampered)
+	}
+
+	body := append(line[:i:i], '}')
+	sum := sha256.Sum256(body)
+	if hex.EncodeToString(sum[:]) != string(line[i+len(hashSuffix):len(line)-2]) {
+		return fmt.Errorf("%w: hash mismatch", ErrTampered)
+	}
+
+	err := json.Unmarshal(line, r)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTampered, err)
+	}
+
+	return nil
+}
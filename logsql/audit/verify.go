@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Verify reads audit records from r and checks that every record is intact and follows the previous one. The last
+// record is returned, it is zero if there are no records. *VerifyError wrapping ErrTampered, ErrGap or ErrTruncated
+// is returned for the first invalid record
+func Verify(r io.Reader) (Record, error) {
+	last, _, err := verify(r)
+	return last, err
+}
+
+// VerifyFile verifies audit file at path, see Verify
+func VerifyFile(path string) (Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Record{}, err
+	}
+	defer f.Close()
+
+	return Verify(f)
+}
+
+// Repair removes the last record of audit file at path if it is truncated, see ErrTruncated. Other records are
+// verified and left intact, file is not modified if any of them is invalid. The last intact record is returned
+func Repair(path string) (Record, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return Record{}, err
+	}
+	defer f.Close()
+
+	last, size, err := verify(f)
+	if !errors.Is(err, ErrTruncated) {
+		return last, err
+	}
+
+	err = f.Truncate(size)
+	if err == nil {
+		err = f.Sync()
+	}
+
+	return last, err
+}
+
+// verify is Verify that also returns size of verified records
+func verify(r io.Reader) (Record, int64, error) {
+	var (
+		last Record
+		size int64
+	)
+
+	br := bufio.NewReader(r)
+	for n := 1; ; n++ {
+		line, err := br.ReadBytes('\n')
+		if len(line) == 0 && errors.Is(err, io.EOF) {
+			return last, size, nil
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return last, size, err
+		}
+		if err != nil {
+			return last, size, &VerifyError{Line: n, Err: ErrTruncated}
+		}
+
+		var rec Record
+		err = rec.unmarshal(bytes.TrimSuffix(line, []byte("\n")))
+		if err != nil {
+			return last, size, &VerifyError{Line: n, Err: err}
+		}
+
+		if rec.Seq != last.Seq+1 {
+			return last, size, &VerifyError{Line: n, Err: fmt.Errorf("%w: expected seq %d, got %d", ErrGap, last.Seq+1,
+				rec.Seq)}
+		}
+		if rec.PrevHash != last.Hash {
+			return last, size, &VerifyError{Line: n, Err: fmt.Errorf("%w: previous hash mismatch", ErrTampered)}
+		}
+
+		last, size = rec, size+int64(len(line))
+	}
+}
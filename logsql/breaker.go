@@ -0,0 +1,246 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerOpen
+	BreakerHalfOpen
+)
+
+type (
+	// BreakerState is a state of CircuitBreaker
+	BreakerState uint8
+
+	// CircuitBreakerConfig configures CircuitBreaker. Breaker opens after Threshold consecutive failures and fails
+	// all calls fast during OpenTimeout. After that it becomes half-open and lets through at most HalfOpenProbes
+	// concurrent calls: if all of them succeed, breaker closes, if any fails, breaker opens again.
+	// IsFailure decides whether error counts as failure, errors that are not failures are treated as successful calls
+	// since the database is reachable. Errors caused by context of the call that was canceled or exceeded deadline set
+	// by the caller are neither failures nor successes. If IsFailure is nil, IsConnectivityFailure is used.
+	// Zero Threshold, OpenTimeout and HalfOpenProbes are replaced by 5, 5 seconds and 1 respectively
+	CircuitBreakerConfig struct {
+		Threshold      int
+		OpenTimeout    time.Duration
+		HalfOpenProbes int
+		IsFailure      func(err error) bool
+	}
+
+	// CircuitBreaker protects the database from calls while it is unavailable. Set it to Config.Breaker to make
+	// connector fail fast with *CircuitOpenError on Connect, Exec and Query calls while breaker is open. The same
+	// breaker can be shared by several connectors. It is safe for concurrent use
+	CircuitBreaker struct {
+		cfg CircuitBreakerConfig
+
+		mu        sync.Mutex
+		state     BreakerState
+		failures  int
+		openedAt  time.Time
+		probes    int
+		successes int
+	}
+
+	// CircuitOpenError is returned by calls rejected by open CircuitBreaker. It matches ErrCircuitOpen via
+	// [errors.Is]
+	CircuitOpenError struct {
+		// RetryAfter is a time left before breaker becomes half-open, zero if it is half-open already
+		RetryAfter time.Duration
+	}
+
+	// BreakerLogger can be optionally implemented by Logger to log CircuitBreaker state transitions. err is an error
+	// that caused transition, nil for transition from open to half-open
+	BreakerLogger interface {
+		BreakerStateChange(ctx context.Context, from BreakerState, to BreakerState, err error)
+	}
+)
+
+// NewCircuitBreaker returns new closed CircuitBreaker
+func NewCircuitBreaker(cfg CircuitBreakerConfig) *CircuitBreaker {
+	if cfg.Threshold <= 0 {
+		cfg.Threshold = 5
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = 5 * time.Second
+	}
+	if cfg.HalfOpenProbes <= 0 {
+		cfg.HalfOpenProbes = 1
+	}
+	if cfg.IsFailure == nil {
+		cfg.IsFailure = IsConnectivityFailure
+	}
+
+	return &CircuitBreaker{
+		cfg: cfg,
+	}
+}
+
+// clone returns new closed CircuitBreaker with the same config, nil if b is nil
+func (b *CircuitBreaker) clone() *CircuitBreaker {
+	if b == nil {
+		return nil
+	}
+
+	return &CircuitBreaker{
+		cfg: b.cfg,
+	}
+}
+
+// IsConnectivityFailure reports whether err means that the database is unreachable: [driver.ErrBadConn], network
+// errors, refused or reset connections, unexpected EOF and expired deadlines
+func IsConnectivityFailure(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, driver.ErrBadConn) || errors.As(err, &netErr) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrCircuitOpen, e.RetryAfter)
+}
+
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// State returns current state of the breaker
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.cfg.OpenTimeout {
+		return BreakerHalfOpen
+	}
+
+	return b.state
+}
+
+// allow returns error if call must be rejected, probe is true if call is a half-open probe
+func (b *CircuitBreaker) allow(ctx context.Context, l Logger) (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen {
+		left := b.cfg.OpenTimeout - time.Since(b.openedAt)
+		if left > 0 {
+			return false, &CircuitOpenError{RetryAfter: left}
+		}
+
+		b.transition(ctx, l, BreakerHalfOpen, nil)
+	}
+
+	if b.state == BreakerHalfOpen {
+		if b.probes >= b.cfg.HalfOpenProbes {
+			return false, &CircuitOpenError{}
+		}
+
+		b.probes++
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (b *CircuitBreaker) report(ctx context.Context, l Logger, probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err != nil && callerGaveUp(ctx, err) {
+		if probe && b.state == BreakerHalfOpen {
+			b.probes--
+		}
+		return
+	}
+
+	failure := err != nil && b.cfg.IsFailure(err)
+
+	if probe && b.state == BreakerHalfOpen {
+		b.probes--
+		if failure {
+			b.transition(ctx, l, BreakerOpen, err)
+			return
+		}
+
+		b.successes++
+		if b.successes >= b.cfg.HalfOpenProbes {
+			b.transition(ctx, l, BreakerClosed, nil)
+		}
+		return
+	}
+
+	if b.state != BreakerClosed {
+		return
+	}
+
+	if !failure {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.cfg.Threshold {
+		b.transition(ctx, l, BreakerOpen, err)
+	}
+}
+
+func (b *CircuitBreaker) transition(ctx context.Context, l Logger, to BreakerState, err error) {
+	from := b.state
+
+	b.state = to
+	b.failures = 0
+	b.probes = 0
+	b.successes = 0
+	if to == BreakerOpen {
+		b.openedAt = time.Now()
+	}
+
+	if bl, ok := l.(BreakerLogger); ok {
+		bl.BreakerStateChange(ctx, from, to, err)
+	}
+}
+
+// callerGaveUp reports whether err is caused by ctx that was canceled or exceeded deadline of the caller, such error
+// says nothing about the database. Default timeouts do not count as deadlines of the caller
+func callerGaveUp(ctx context.Context, err error) bool {
+	return ctx.Err() != nil && ClassifyErr(ctx, err).IsContextErr() && !errors.Is(context.Cause(ctx), errDefaultTimeout)
+}
+
+// withBreaker performs call if breaker allows it and reports its result. Nil breaker allows every call
+func withBreaker[T any](ctx context.Context, b *CircuitBreaker, l Logger, call func() (T, error)) (T, error) {
+	if b == nil {
+		return call()
+	}
+
+	probe, err := b.allow(ctx, l)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	v, err := call()
+	b.report(ctx, l, probe, err)
+
+	return v, err
+}
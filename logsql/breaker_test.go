@@ -0,0 +1,154 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+type (
+	// breakerLogger records breaker transitions
+	breakerLogger struct {
+		*countingLogger
+		transitions []string
+	}
+)
+
+func (l *breakerLogger) BreakerStateChange(_ context.Context, from BreakerState, to BreakerState, _ error) {
+	l.transitions = append(l.transitions, from.String()+" -> "+to.String())
+}
+
+// callBreaker performs call that returns err through breaker
+func callBreaker(ctx context.Context, b *CircuitBreaker, l Logger, err error) error {
+	_, err = withBreaker(ctx, b, l, func() (struct{}, error) {
+		return struct{}{}, err
+	})
+	return err
+}
+
+func TestBreakerTransitions(t *testing.T) {
+	l := &breakerLogger{countingLogger: &countingLogger{events: make(map[string]int)}}
+	b := NewCircuitBreaker(CircuitBreakerConfig{Threshold: 2, OpenTimeout: 30 * time.Millisecond, HalfOpenProbes: 2})
+	ctx := context.Background()
+
+	_ = callBreaker(ctx, b, l, driver.ErrBadConn)
+	_ = callBreaker(ctx, b, l, nil)
+	_ = callBreaker(ctx, b, l, driver.ErrBadConn)
+	_ = callBreaker(ctx, b, l, errors.New("syntax error"))
+	if b.State() != BreakerClosed {
+		t.Fatalf("expected successes and non-connectivity errors to reset failures, got %s", b.State())
+	}
+
+	_ = callBreaker(ctx, b, l, driver.ErrBadConn)
+	_ = callBreaker(ctx, b, l, driver.ErrBadConn)
+	if b.State() != BreakerOpen {
+		t.Fatalf("expected breaker to open after 2 failures, got %s", b.State())
+	}
+
+	var openErr *CircuitOpenError
+	if err := callBreaker(ctx, b, l, nil); !errors.As(err, &openErr) || openErr.RetryAfter <= 0 {
+		t.Fatalf("expected call to be rejected with retry after, got %v", err)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if b.State() != BreakerHalfOpen {
+		t.Fatalf("expected breaker to be half-open after open timeout, got %s", b.State())
+	}
+
+	probes := make([]bool, 0, 2)
+	for range 2 {
+		probe, err := b.allow(ctx, l)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		probes = append(probes, probe)
+	}
+	if _, err := b.allow(ctx, l); !errors.As(err, &openErr) || openErr.RetryAfter != 0 {
+		t.Fatalf("expected calls over probes limit to be rejected, got %v", err)
+	}
+
+	b.report(ctx, l, probes[0], nil)
+	if b.State() != BreakerHalfOpen {
+		t.Fatalf("expected breaker to wait for all probes, got %s", b.State())
+	}
+	b.report(ctx, l, probes[1], nil)
+	if b.State() != BreakerClosed {
+		t.Fatalf("expected breaker to close after successful probes, got %s", b.State())
+	}
+
+	_ = callBreaker(ctx, b, l, driver.ErrBadConn)
+	_ = callBreaker(ctx, b, l, driver.ErrBadConn)
+	time.Sleep(30 * time.Millisecond)
+	_ = callBreaker(ctx, b, l, driver.ErrBadConn)
+	if b.State() != BreakerOpen {
+		t.Fatalf("expected failed probe to open breaker, got %s", b.State())
+	}
+
+	expected := []string{"closed -> open", "open -> half-open", "half-open -> closed", "closed -> open",
+		"open -> half-open", "half-open -> open"}
+	if !slices.Equal(l.transitions, expected) {
+		t.Errorf("expected transitions %q, got %q", expected, l.transitions)
+	}
+}
+
+func TestBreakerCallerContext(t *testing.T) {
+	l := &breakerLogger{countingLogger: &countingLogger{events: make(map[string]int)}}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+	defaultTimeout, cancel, _ := Timeouts{Exec: time.Nanosecond}.withTimeout(context.Background(), OpExec)
+	defer cancel()
+	<-defaultTimeout.Done()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		err     error
+		failure bool
+	}{
+		{name: "caller canceled", ctx: canceled, err: context.Canceled},
+		{name: "caller deadline", ctx: expired, err: context.DeadlineExceeded},
+		{name: "caller deadline driver error", ctx: expired, err: errors.New("i/o timeout")},
+		{name: "bad conn after caller canceled", ctx: canceled, err: driver.ErrBadConn, failure: true},
+		{name: "driver deadline", ctx: context.Background(), err: context.DeadlineExceeded, failure: true},
+		{name: "default timeout", ctx: defaultTimeout, err: context.DeadlineExceeded, failure: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewCircuitBreaker(CircuitBreakerConfig{Threshold: 1})
+			_ = callBreaker(tt.ctx, b, l, tt.err)
+
+			if failure := b.State() == BreakerOpen; failure != tt.failure {
+				t.Errorf("expected failure %t, got %t", tt.failure, failure)
+			}
+		})
+	}
+}
+
+func TestBreakerProbeCallerCanceled(t *testing.T) {
+	l := &breakerLogger{countingLogger: &countingLogger{events: make(map[string]int)}}
+	b := NewCircuitBreaker(CircuitBreakerConfig{Threshold: 1, OpenTimeout: time.Millisecond})
+
+	_ = callBreaker(context.Background(), b, l, driver.ErrBadConn)
+	time.Sleep(time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_ = callBreaker(ctx, b, l, context.Canceled)
+	if b.State() != BreakerHalfOpen {
+		t.Fatalf("expected canceled probe to keep breaker half-open, got %s", b.State())
+	}
+
+	if err := callBreaker(context.Background(), b, l, nil); err != nil {
+		t.Fatalf("expected probe slot to be released, got %v", err)
+	}
+	if b.State() != BreakerClosed {
+		t.Errorf("expected successful probe to close breaker, got %s", b.State())
+	}
+}
@@ -0,0 +1,179 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	maxNPlusOneCallers = 10
+)
+
+type (
+	// BudgetConfig configures handling of budgets attached to contexts by WithBudget. If Enforce is true, calls
+	// exceeding budget fail with *BudgetError, otherwise budget overrun is only logged via BudgetLogger.
+	// Fingerprint executed more than NPlusOneThreshold times within the same budget is reported as N+1 pattern,
+	// zero NPlusOneThreshold is replaced by 10
+	BudgetConfig struct {
+		Enforce           bool
+		NPlusOneThreshold int
+	}
+
+	// BudgetUsage describes queries executed within budget. Zero limits mean no limit
+	BudgetUsage struct {
+		Queries     int
+		Duration    time.Duration
+		MaxQueries  int
+		MaxDuration time.Duration
+	}
+
+	// BudgetError is returned for calls exceeding budget if BudgetConfig.Enforce is true. It matches
+	// ErrBudgetExceeded via [errors.Is]
+	BudgetError struct {
+		Usage BudgetUsage
+	}
+
+	// BudgetLogger can be optionally implemented by Logger to log budget overruns and N+1 patterns
+	BudgetLogger interface {
+		// BudgetExceeded is called once per budget for the first call exceeding it
+		BudgetExceeded(ctx context.Context, query string, usage BudgetUsage)
+		// NPlusOne is called once per fingerprint when it is executed more than NPlusOneThreshold times. callers
+		// are distinct locations of calls outside of [database/sql] and this package, at most 10 of them
+		NPlusOne(ctx context.Context, fingerprint string, count int, callers []string)
+	}
+
+	budget struct {
+		mu           sync.Mutex
+		usage        BudgetUsage
+		exceeded     bool
+		fingerprints map[string]*fingerprintUsage
+	}
+
+	fingerprintUsage struct {
+		count    int
+		callers  []string
+		reported bool
+	}
+)
+
+// WithBudget attaches budget to ctx, e.g. of incoming request. Exec and Query calls with ctx are counted against
+// it and at most maxQueries of them with total duration of maxTotalDuration are allowed. Zero limits mean no limit,
+// so WithBudget(ctx, 0, 0) only detects N+1 patterns. Nested budget replaces the outer one
+func WithBudget(ctx context.Context, maxQueries int, maxTotalDuration time.Duration) context.Context {
+	return context.WithValue(ctx, ctxKeyBudget, &budget{
+		usage: BudgetUsage{
+			MaxQueries:  maxQueries,
+			MaxDuration: maxTotalDuration,
+		},
+		fingerprints: make(map[string]*fingerprintUsage),
+	})
+}
+
+// BudgetUsageFromContext returns current usage of budget attached to ctx. ok is false if ctx has no budget
+func BudgetUsageFromContext(ctx context.Context) (usage BudgetUsage, ok bool) {
+	b, ok := ctx.Value(ctxKeyBudget).(*budget)
+	if !ok {
+		return BudgetUsage{}, false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.usage, true
+}
+
+func (e *BudgetError) Error() string {
+	return fmt.Sprintf("%s: %d/%d queries, %s/%s", ErrBudgetExceeded, e.Usage.Queries, e.Usage.MaxQueries,
+		e.Usage.Duration, e.Usage.MaxDuration)
+}
+
+func (e *BudgetError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+func (c BudgetConfig) withDefaults() BudgetConfig {
+	if c.NPlusOneThreshold <= 0 {
+		c.NPlusOneThreshold = 10
+	}
+
+	return c
+}
+
+// exceeded reports whether one more query exceeds usage limits
+func (u BudgetUsage) exceeded() bool {
+	return u.MaxQueries > 0 && u.Queries >= u.MaxQueries || u.MaxDuration > 0 && u.Duration >= u.MaxDuration
+}
+
+// chargeBudget counts query against budget of ctx. It returns function that must be called with duration and error
+// of the query, or *BudgetError if budget is enforced and exceeded. Query that returned [driver.ErrSkip] is not
+// counted, since [database/sql] executes it again another way
+func chargeBudget(ctx context.Context, c *connection, query string) (func(dt time.Duration, err error), error) {
+	b, ok := ctx.Value(ctxKeyBudget).(*budget)
+	if !ok {
+		return func(time.Duration, error) {}, nil
+	}
+
+	bl, _ := c.logHandler.(BudgetLogger)
+
+	// Check and count are done at once, so concurrent queries can not exceed enforced budget
+	b.mu.Lock()
+	exceeded := b.usage.exceeded()
+	reportExceeded := exceeded && !b.exceeded
+	b.exceeded = b.exceeded || exceeded
+	rejected := exceeded && c.budget.Enforce
+	usage := b.usage
+	if !rejected {
+		b.usage.Queries++
+	}
+	b.mu.Unlock()
+
+	if reportExceeded && bl != nil {
+		bl.BudgetExceeded(ctx, query, usage)
+	}
+	if rejected {
+		return nil, &BudgetError{Usage: usage}
+	}
+
+	return func(dt time.Duration, err error) {
+		b.mu.Lock()
+		if errors.Is(err, driver.ErrSkip) {
+			b.usage.Queries--
+		} else {
+			b.usage.Duration += dt
+		}
+		b.mu.Unlock()
+
+		if bl != nil && !errors.Is(err, driver.ErrSkip) {
+			b.detectNPlusOne(ctx, bl, query, c.budget.NPlusOneThreshold)
+		}
+	}, nil
+}
+
+// detectNPlusOne counts fingerprint of query and reports it once it is executed more than threshold times
+func (b *budget) detectNPlusOne(ctx context.Context, bl BudgetLogger, query string, threshold int) {
+	fingerprint, caller := Fingerprint(query), callerOutside()
+
+	b.mu.Lock()
+	fu, ok := b.fingerprints[fingerprint]
+	if !ok {
+		fu = &fingerprintUsage{}
+		b.fingerprints[fingerprint] = fu
+	}
+	fu.count++
+	if len(fu.callers) < maxNPlusOneCallers && !slices.Contains(fu.callers, caller) {
+		fu.callers = append(fu.callers, caller)
+	}
+	report := fu.count > threshold && !fu.reported
+	fu.reported = fu.reported || report
+	count, callers := fu.count, slices.Clone(fu.callers)
+	b.mu.Unlock()
+
+	if report {
+		bl.NPlusOne(ctx, fingerprint, count, callers)
+	}
+}
@@ -0,0 +1,154 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+type (
+	// budgetLogger records BudgetLogger events
+	budgetLogger struct {
+		*countingLogger
+		exceeded []BudgetUsage
+		nPlusOne map[string]int
+	}
+)
+
+func newBudgetLogger() *budgetLogger {
+	return &budgetLogger{
+		countingLogger: &countingLogger{events: make(map[string]int)},
+		nPlusOne:       make(map[string]int),
+	}
+}
+
+func (l *budgetLogger) BudgetExceeded(_ context.Context, _ string, usage BudgetUsage) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.exceeded = append(l.exceeded, usage)
+}
+
+func (l *budgetLogger) NPlusOne(_ context.Context, fingerprint string, count int, _ []string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.nPlusOne[fingerprint] = count
+}
+
+func openBudgetDB(cfg fakedriver.Config, l Logger, budgetCfg BudgetConfig) *sql.DB {
+	cfg.Features = fakedriver.FeatureAll
+	return sql.OpenDB(NewConnectorFromConnector(fakedriver.New(cfg), Config{LogHandler: l, Budget: budgetCfg}))
+}
+
+func TestBudgetEnforce(t *testing.T) {
+	for _, enforce := range []bool{true, false} {
+		t.Run(fmt.Sprintf("enforce=%t", enforce), func(t *testing.T) {
+			l := newBudgetLogger()
+			db := openBudgetDB(fakedriver.Config{}, l, BudgetConfig{Enforce: enforce})
+			defer db.Close()
+
+			ctx := WithBudget(context.Background(), 2, 0)
+			var errs []error
+			for range 4 {
+				_, err := db.ExecContext(ctx, "UPDATE t SET a = 1")
+				errs = append(errs, err)
+			}
+
+			for i, err := range errs {
+				var budgetErr *BudgetError
+				rejected := errors.As(err, &budgetErr) && errors.Is(err, ErrBudgetExceeded)
+				if want := enforce && i >= 2; rejected != want || !rejected && err != nil {
+					t.Errorf("query %d: expected rejected=%t, got %v", i, want, err)
+				}
+			}
+
+			usage, _ := BudgetUsageFromContext(ctx)
+			if want := map[bool]int{true: 2, false: 4}[enforce]; usage.Queries != want {
+				t.Errorf("expected %d counted queries, got %d", want, usage.Queries)
+			}
+			if len(l.exceeded) != 1 || l.exceeded[0].Queries != 2 {
+				t.Errorf("expected budget overrun to be reported once, got %v", l.exceeded)
+			}
+		})
+	}
+}
+
+func TestBudgetConcurrent(t *testing.T) {
+	db := openBudgetDB(fakedriver.Config{Default: fakedriver.Response{Latency: 10 * time.Millisecond}},
+		newBudgetLogger(), BudgetConfig{Enforce: true})
+	defer db.Close()
+
+	ctx := WithBudget(context.Background(), 5, 0)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := db.ExecContext(ctx, "UPDATE t SET a = 1"); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != 5 {
+		t.Errorf("expected exactly 5 queries within budget, got %d", succeeded)
+	}
+}
+
+func TestBudgetSkipFallback(t *testing.T) {
+	l := newBudgetLogger()
+	db := openBudgetDB(fakedriver.Config{
+		Rules: []fakedriver.Rule{{Times: 1, Response: fakedriver.Response{Err: driver.ErrSkip}}},
+	}, l, BudgetConfig{Enforce: true, NPlusOneThreshold: 1})
+	defer db.Close()
+
+	ctx := WithBudget(context.Background(), 1, 0)
+	if _, err := db.ExecContext(ctx, "UPDATE t SET a = $1", 1); err != nil {
+		t.Fatalf("expected fallback not to be counted, got %v", err)
+	}
+
+	if usage, _ := BudgetUsageFromContext(ctx); usage.Queries != 1 {
+		t.Errorf("expected 1 counted query, got %d", usage.Queries)
+	}
+	if len(l.nPlusOne) != 0 {
+		t.Errorf("expected fallback not to be counted as repeated query, got %v", l.nPlusOne)
+	}
+}
+
+func TestBudgetNPlusOne(t *testing.T) {
+	l := newBudgetLogger()
+	db := openBudgetDB(fakedriver.Config{}, l, BudgetConfig{NPlusOneThreshold: 3})
+	defer db.Close()
+
+	ctx := WithBudget(context.Background(), 0, 0)
+	for i := range 6 {
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("UPDATE t SET a = 1 WHERE id = %d", i)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := db.ExecContext(ctx, "UPDATE u SET a = 1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fingerprint := Fingerprint("UPDATE t SET a = 1 WHERE id = 0")
+	if len(l.nPlusOne) != 1 || l.nPlusOne[fingerprint] != 4 {
+		t.Errorf("expected %q to be reported once at 4th call, got %v", fingerprint, l.nPlusOne)
+	}
+	if len(l.exceeded) != 0 {
+		t.Errorf("expected unlimited budget not to be exceeded, got %v", l.exceeded)
+	}
+}
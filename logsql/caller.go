@@ -0,0 +1,30 @@
+package logsql
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// callerOutside returns location of the first caller outside of [database/sql] and this package in form
+// "function file:line", empty string if there is no such caller
+func callerOutside() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !isInternalFrame(frame.Function) {
+			return fmt.Sprintf("%s %s:%d", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+func isInternalFrame(function string) bool {
+	return strings.HasPrefix(function, "database/sql.") || strings.HasPrefix(function, "runtime.") ||
+		strings.HasPrefix(function, "github.com/alsiberij/sqlutils/logsql.")
+}
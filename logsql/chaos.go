@@ -0,0 +1,105 @@
+package logsql
+
+import (
+	"context"
+	"math/rand/v2"
+	"regexp"
+	"sync"
+	"time"
+)
+
+const (
+	// FaultConnect is injected in Connect of the connector
+	FaultConnect FaultKind = iota
+	// FaultPrepare is injected in Prepare and PrepareContext
+	FaultPrepare
+	// FaultBegin is injected in Begin and BeginTx
+	FaultBegin
+	// FaultExec is injected in Exec and ExecContext of connections and prepared statements
+	FaultExec
+	// FaultQuery is injected in Query and QueryContext of connections and prepared statements
+	FaultQuery
+	// FaultCommit is injected in Commit. Underlying transaction is rolled back if error is injected
+	FaultCommit
+	// FaultRowsNext is injected in Next of rows, so iteration fails in the middle
+	FaultRowsNext
+)
+
+type (
+	// FaultKind defines where Fault is injected
+	FaultKind uint8
+
+	// Fault describes failure injected by chaos connector. Fault is triggered with Probability (0 never, 1 always)
+	// for operations of Kind. If Pattern is not nil, only operations with query matching it are affected
+	// (FaultConnect, FaultBegin and FaultCommit are not related to any query, so Pattern is ignored for them).
+	// Triggered fault waits Latency and then returns Err instead of calling underlying driver. If Err is nil,
+	// operation is performed as usual after Latency
+	Fault struct {
+		Kind        FaultKind
+		Pattern     *regexp.Regexp
+		Probability float64
+		Latency     time.Duration
+		Err         error
+	}
+
+	// ChaosConfig describes faults injected by NewChaosConnector. Faults are checked in order, the first triggered
+	// fault of the kind is injected. Seed makes injection deterministic: the same sequence of operations results in
+	// the same faults
+	ChaosConfig struct {
+		Seed   uint64
+		Faults []Fault
+	}
+
+	chaos struct {
+		faults []Fault
+
+		mu  sync.Mutex
+		rnd *rand.Rand
+	}
+)
+
+func newChaos(cfg ChaosConfig) *chaos {
+	return &chaos{
+		faults: cfg.Faults,
+		rnd:    rand.New(rand.NewPCG(cfg.Seed, cfg.Seed)),
+	}
+}
+
+// trigger returns fault of kind that should be injected for query
+func (c *chaos) trigger(kind FaultKind, query string) (Fault, bool) {
+	for _, f := range c.faults {
+		if f.Kind != kind {
+			continue
+		}
+		if f.Pattern != nil && kind != FaultConnect && kind != FaultBegin && kind != FaultCommit &&
+			!f.Pattern.MatchString(query) {
+			continue
+		}
+		if c.roll() < f.Probability {
+			return f, true
+		}
+	}
+
+	return Fault{}, false
+}
+
+func (c *chaos) roll() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.rnd.Float64()
+}
+
+// inject waits fault latency if fault is triggered and returns its error. Waiting is interrupted if ctx is done
+func (c *chaos) inject(ctx context.Context, kind FaultKind, query string) error {
+	f, ok := c.trigger(kind, query)
+	if !ok {
+		return nil
+	}
+
+	if f.Latency > 0 && !sleep(ctx, f.Latency) {
+		return ctx.Err()
+	}
+
+	return f.Err
+}
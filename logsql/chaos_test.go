@@ -0,0 +1,144 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+var errInjected = errors.New("injected")
+
+// injections returns which of n operations of kind got fault injected by chaos with cfg
+func injections(cfg ChaosConfig, kind FaultKind, n int) []bool {
+	c := newChaos(cfg)
+
+	result := make([]bool, n)
+	for i := range result {
+		result[i] = c.inject(context.Background(), kind, "SELECT 1") != nil
+	}
+
+	return result
+}
+
+func TestChaosProbability(t *testing.T) {
+	fault := func(seed uint64, probability float64) ChaosConfig {
+		return ChaosConfig{
+			Seed:   seed,
+			Faults: []Fault{{Kind: FaultQuery, Probability: probability, Err: errInjected}},
+		}
+	}
+
+	first := injections(fault(42, 0.5), FaultQuery, 1000)
+	if second := injections(fault(42, 0.5), FaultQuery, 1000); !slices.Equal(first, second) {
+		t.Errorf("expected the same faults for the same seed")
+	}
+	if other := injections(fault(43, 0.5), FaultQuery, 1000); slices.Equal(first, other) {
+		t.Errorf("expected other faults for another seed")
+	}
+
+	injected := 0
+	for _, ok := range first {
+		if ok {
+			injected++
+		}
+	}
+	if injected < 400 || injected > 600 {
+		t.Errorf("expected about half of operations to fail, got %d of 1000", injected)
+	}
+
+	if slices.Contains(injections(fault(42, 0), FaultQuery, 1000), true) {
+		t.Errorf("expected no faults with zero probability")
+	}
+	if slices.Contains(injections(fault(42, 1), FaultQuery, 1000), false) {
+		t.Errorf("expected every operation to fail with probability 1")
+	}
+	if slices.Contains(injections(fault(42, 1), FaultExec, 1000), true) {
+		t.Errorf("expected faults of other kinds not to be injected")
+	}
+}
+
+func TestChaosTargeting(t *testing.T) {
+	errCommitted := errors.New("committed")
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Commit:   fakedriver.Outcome{Err: errCommitted},
+		Default:  fakedriver.Response{Columns: []string{"a"}, Rows: [][]driver.Value{{int64(1)}}},
+	})
+
+	errCommit := errors.New("injected commit")
+	db := sql.OpenDB(NewConnectorFromConnector(NewChaosConnector(d, ChaosConfig{
+		Faults: []Fault{
+			{Kind: FaultQuery, Pattern: regexp.MustCompile(`^SELECT`), Probability: 1, Err: errInjected},
+			{Kind: FaultRowsNext, Pattern: regexp.MustCompile(`FROM rows`), Probability: 1, Err: errInjected},
+			{Kind: FaultCommit, Pattern: regexp.MustCompile(`ignored`), Probability: 1, Err: errCommit},
+		},
+	}), Config{LogHandler: &countingLogger{events: make(map[string]int)}}))
+	defer db.Close()
+
+	if _, err := db.Exec("UPDATE t SET a = 1"); err != nil {
+		t.Errorf("expected exec not to be affected, got %v", err)
+	}
+	if _, err := db.Query("SELECT a FROM t"); !errors.Is(err, errInjected) {
+		t.Errorf("expected query matching pattern to fail, got %v", err)
+	}
+
+	rows, err := db.Query("WITH r AS (SELECT a FROM rows) TABLE r")
+	if err != nil {
+		t.Fatalf("expected query not matching pattern to succeed, got %v", err)
+	}
+	if rows.Next() || !errors.Is(rows.Err(), errInjected) {
+		t.Errorf("expected iteration to fail, got %v", rows.Err())
+	}
+	_ = rows.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = tx.Commit(); !errors.Is(err, errCommit) {
+		t.Errorf("expected commit to fail regardless of pattern without committing, got %v", err)
+	}
+}
+
+func TestChaosLatency(t *testing.T) {
+	d := fakedriver.New(fakedriver.Config{Features: fakedriver.FeatureAll})
+	connector := NewChaosConnector(d, ChaosConfig{
+		Faults: []Fault{
+			{Kind: FaultExec, Pattern: regexp.MustCompile(`slow`), Probability: 1, Latency: time.Hour},
+			{Kind: FaultExec, Probability: 1, Latency: 20 * time.Millisecond},
+		},
+	})
+
+	conn, err := connector.Connect(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer conn.Close()
+	execer := conn.(driver.ExecerContext)
+
+	t0 := time.Now()
+	if _, err = execer.ExecContext(context.Background(), "UPDATE t SET a = 1", nil); err != nil {
+		t.Errorf("expected operation to succeed after latency, got %v", err)
+	}
+	if dt := time.Since(t0); dt < 20*time.Millisecond {
+		t.Errorf("expected latency of 20ms, got %s", dt)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	t0 = time.Now()
+	if _, err = execer.ExecContext(ctx, "UPDATE slow SET a = 1", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected latency to be interrupted by context, got %v", err)
+	}
+	if dt := time.Since(t0); dt > time.Second {
+		t.Errorf("expected latency to be interrupted by context, waited %s", dt)
+	}
+}
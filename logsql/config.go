@@ -2,10 +2,40 @@ package logsql
 
 type (
 	// Config must contain Logger for logging sql.DB events.
-	// If Qer is nil, NoOpQueryErrReplacer will be used
+	// If Qer is nil, NoOpQueryErrReplacer will be used.
+	// If SkipQerOnCtxErr is true, Qer is not applied to errors caused by cancellation or deadline, see ErrClass.
+	// If Retry is not nil, failed Exec and Query calls outside transactions are retried according to it.
+	// If Breaker is not nil, Connect, Exec and Query calls fail fast while it is open.
+	// If Limiter is not nil, concurrency and rate of Exec and Query calls are limited by it.
+	// If Policy is not nil, queries violating it are rejected before they reach the driver.
+	// OnConnect is called for every new connection, connection is closed and Connect fails if it returns error.
+	// OnReset is called when pooled connection is reused after session reset, connection is discarded if it returns
+	// error.
+	// If Session is not nil, session settings required by context are applied to connection before queries and
+	// transactions.
+	// If StmtCache is not nil, Exec and Query calls of connections use cached prepared statements.
+	// If ResultCache is not nil, results of selected queries are cached by it.
+	// If Registry is not nil, in-flight operations are tracked by it.
+	// If Explain is not nil, plans of slow SELECT queries are captured.
+	// Budget configures handling of budgets attached to contexts by WithBudget.
+	// Timeouts are applied to operations which context has no deadline
 	Config struct {
-		Qer        QueryErrReplacer
-		LogHandler Logger
+		Qer             QueryErrReplacer
+		SkipQerOnCtxErr bool
+		LogHandler      Logger
+		Retry           *RetryPolicy
+		Breaker         *CircuitBreaker
+		Limiter         *Limiter
+		Policy          *QueryPolicy
+		OnConnect       ConnHook
+		OnReset         ConnHook
+		Session         *SessionConfig
+		StmtCache       *StmtCacheConfig
+		ResultCache     *ResultCache
+		Registry        *Registry
+		Explain         *ExplainConfig
+		Budget          BudgetConfig
+		Timeouts        Timeouts
 	}
 )
 
@@ -15,5 +45,47 @@ func (c Config) Validate() error {
 		return ErrNilLogHandler
 	}
 
+	if c.Explain != nil && c.Explain.Dialect.Explain == "" {
+		return ErrExplainUnsupported
+	}
+
 	return nil
 }
+
+// withDefaults returns copy of config with default values of omitted optional fields
+func (c Config) withDefaults() Config {
+	if c.Qer == nil {
+		c.Qer = NoOpQueryErrReplacer
+	}
+
+	if c.Retry != nil {
+		retry := c.Retry.withDefaults()
+		c.Retry = &retry
+	}
+
+	if c.Session != nil {
+		session := c.Session.withDefaults()
+		c.Session = &session
+	}
+
+	if c.Explain != nil {
+		explain := c.Explain.withDefaults()
+		c.Explain = &explain
+	}
+
+	c.Budget = c.Budget.withDefaults()
+
+	if c.StmtCache != nil {
+		stmtCache := c.StmtCache.withDefaults()
+		c.StmtCache = &stmtCache
+	}
+
+	return c
+}
+
+// withOwnBreaker returns copy of config with new CircuitBreaker configured as Breaker, so connectors of different
+// targets do not share its state
+func (c Config) withOwnBreaker() Config {
+	c.Breaker = c.Breaker.clone()
+	return c
+}
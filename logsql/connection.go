@@ -3,19 +3,82 @@ package logsql
 import (
 	"context"
 	"database/sql/driver"
+	"fmt"
 	"time"
 )
 
+var (
+	_ driver.Conn               = (*connection)(nil)
+	_ driver.ConnBeginTx        = (*connection)(nil)
+	_ driver.ConnPrepareContext = (*connection)(nil)
+	_ driver.Execer             = (*connection)(nil)
+	_ driver.ExecerContext      = (*connection)(nil)
+	_ driver.Queryer            = (*connection)(nil)
+	_ driver.QueryerContext     = (*connection)(nil)
+	_ driver.Pinger             = (*connection)(nil)
+	_ driver.SessionResetter    = (*connection)(nil)
+	_ driver.Validator          = (*connection)(nil)
+	_ driver.NamedValueChecker  = (*connection)(nil)
+)
+
 type (
 	connection struct {
 		logHandler       Logger
 		queryErrReplacer QueryErrReplacer
-
-		conn driver.Conn
+		skipQerOnCtxErr  bool
+		retryPolicy      *RetryPolicy
+		breaker          *CircuitBreaker
+		limiter          *Limiter
+		policy           *QueryPolicy
+		onReset          ConnHook
+		session          *SessionConfig
+		stmtCache        *stmtCache
+		resultCache      *ResultCache
+		registry         *Registry
+		budget           BudgetConfig
+		explainer        *explainer
+		timeouts         Timeouts
+
+		conn         driver.Conn
+		inTx         bool
+		sessionState sessionState
+		// id and txID identify connection and its current transaction in Registry
+		id   uint64
+		txID uint64
 	}
 )
 
+func newConnection(cfg Config, conn driver.Conn) *connection {
+	c := &connection{
+		logHandler:       cfg.LogHandler,
+		queryErrReplacer: cfg.Qer,
+		skipQerOnCtxErr:  cfg.SkipQerOnCtxErr,
+		retryPolicy:      cfg.Retry,
+		breaker:          cfg.Breaker,
+		limiter:          cfg.Limiter,
+		policy:           cfg.Policy,
+		onReset:          cfg.OnReset,
+		session:          cfg.Session,
+		stmtCache:        newStmtCache(cfg.StmtCache),
+		resultCache:      cfg.ResultCache,
+		registry:         cfg.Registry,
+		budget:           cfg.Budget,
+		timeouts:         cfg.Timeouts,
+		conn:             conn,
+	}
+
+	if cfg.Registry != nil {
+		c.id = cfg.Registry.nextConnID.Add(1)
+	}
+
+	return c
+}
+
 func (c *connection) Prepare(query string) (driver.Stmt, error) {
+	if err := c.policy.check(context.Background(), c.logHandler, query); err != nil {
+		return nil, err
+	}
+
 	t0 := time.Now()
 
 	stmt, err := c.conn.Prepare(query)
@@ -25,15 +88,17 @@ func (c *connection) Prepare(query string) (driver.Stmt, error) {
 	}
 
 	return &queryStatement{
-		logHandler:       c.logHandler,
-		queryErrReplacer: c.queryErrReplacer,
-		connCtx:          context.Background(),
-		query:            query,
-		statement:        stmt,
+		logHandler: c.logHandler,
+		conn:       c,
+		connCtx:    context.Background(),
+		query:      query,
+		statement:  stmt,
 	}, nil
 }
 
 func (c *connection) Close() error {
+	c.closeStmtCache()
+
 	t0 := time.Now()
 
 	err := c.conn.Close()
@@ -43,39 +108,79 @@ func (c *connection) Close() error {
 }
 
 func (c *connection) Begin() (driver.Tx, error) {
+	c.txID = txIDs.Add(1)
+	ctx := context.WithValue(context.Background(), ctxKeyTxID, c.txID)
+
 	t0 := time.Now()
 
 	tx, err := c.conn.Begin()
-	c.logHandler.TxBegin(context.Background(), err, time.Since(t0))
+	c.logHandler.TxBegin(ctx, err, time.Since(t0))
 	if err != nil {
+		c.txID = 0
 		return nil, err
 	}
 
+	c.inTx = true
+
 	return &queryTransaction{
 		logHandler:  c.logHandler,
-		connCtx:     context.Background(),
+		conn:        c,
+		connCtx:     ctx,
+		txCtx:       context.Background(),
+		txCancel:    func(error) {},
 		transaction: tx,
-	}, err
+	}, nil
 }
 
 func (c *connection) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if err := c.ensureSession(ctx); err != nil {
+		return nil, err
+	}
+
 	connBeginTx, ok := c.conn.(driver.ConnBeginTx)
 	if !ok {
 		return c.Begin()
 	}
 
+	c.txID = txIDs.Add(1)
+	ctx = context.WithValue(ctx, ctxKeyTxID, c.txID)
+	ctx, op := c.registry.track(ctx, ActiveTx, c, "", nil)
+
 	t0 := time.Now()
 
-	tx, err := connBeginTx.BeginTx(ctx, opts)
+	// Transaction context lives until the end of transaction, so Begin and Commit timeouts cancel it by timers
+	txCtx, txCancel := ctx, context.CancelCauseFunc(func(error) {})
+	beginTimeout, commitTimeout := c.timeouts.forContext(ctx, OpBegin), c.timeouts.forContext(ctx, OpCommit)
+	if beginTimeout > 0 || commitTimeout > 0 {
+		txCtx, txCancel = context.WithCancelCause(ctx)
+	}
+
+	stop := cancelAfter(txCancel, beginTimeout)
+	tx, err := connBeginTx.BeginTx(txCtx, opts)
+	if !stop() && err == nil {
+		_ = tx.Rollback()
+		err = context.Cause(txCtx)
+	}
+	err = checkTimeout(ctx, txCtx, c.logHandler, OpBegin, "", beginTimeout, err)
 	c.logHandler.TxBegin(ctx, err, time.Since(t0))
 	if err != nil {
+		txCancel(nil)
+		op.done()
+		c.txID = 0
 		return nil, err
 	}
 
+	c.inTx = true
+
 	return &queryTransaction{
-		logHandler:  c.logHandler,
-		connCtx:     ctx,
-		transaction: tx,
+		logHandler:    c.logHandler,
+		conn:          c,
+		connCtx:       ctx,
+		txCtx:         txCtx,
+		txCancel:      txCancel,
+		commitTimeout: commitTimeout,
+		op:            op,
+		transaction:   tx,
 	}, nil
 }
 
@@ -85,35 +190,65 @@ func (c *connection) PrepareContext(ctx context.Context, query string) (driver.S
 		return c.Prepare(query)
 	}
 
+	if err := c.policy.check(ctx, c.logHandler, query); err != nil {
+		return nil, err
+	}
+
 	t0 := time.Now()
 
-	stmt, err := connPrepareTx.PrepareContext(ctx, query)
+	tctx, cancel, timeout := c.timeouts.withTimeout(ctx, OpPrepare)
+	defer cancel()
+
+	stmt, err := connPrepareTx.PrepareContext(tctx, query)
+	err = checkTimeout(ctx, tctx, c.logHandler, OpPrepare, query, timeout, err)
 	c.logHandler.PrepareStatement(ctx, query, err, time.Since(t0))
 	if err != nil {
 		return nil, err
 	}
 
 	return &queryStatement{
-		logHandler:       c.logHandler,
-		queryErrReplacer: c.queryErrReplacer,
-		connCtx:          ctx,
-		query:            query,
-		statement:        stmt,
+		logHandler: c.logHandler,
+		conn:       c,
+		connCtx:    ctx,
+		query:      query,
+		statement:  stmt,
 	}, nil
 }
 
 func (c *connection) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.ensureSession(ctx); err != nil {
+		return nil, err
+	}
+	ctx = c.txContext(ctx)
+
 	connExecerCtx, ok := c.conn.(driver.ExecerContext)
-	if !ok {
+	if !ok && c.stmtCache == nil {
 		return c.Exec(query, driverNamedToValues(args))
 	}
 
+	if err := c.policy.check(ctx, c.logHandler, query); err != nil {
+		return nil, err
+	}
+
+	ctx, op := c.registry.track(ctx, ActiveExec, c, query, args)
+	defer op.done()
+
 	t0 := time.Now()
 
-	result, err := connExecerCtx.ExecContext(ctx, query, args)
+	tctx, cancel, timeout := c.timeouts.withTimeout(ctx, OpExec)
+	defer cancel()
+
+	result, release, err := invoke(tctx, c, OpExec, query, func() (driver.Result, error) {
+		if c.stmtCache != nil {
+			return c.execCached(tctx, query, args)
+		}
+		return connExecerCtx.ExecContext(tctx, query, args)
+	})
+	release()
+	err = checkTimeout(ctx, tctx, c.logHandler, OpExec, query, timeout, err)
 	var replacedErr error
 	if err != nil {
-		replacedErr = c.queryErrReplacer(err)
+		replacedErr = c.replaceErr(ctx, err)
 	}
 	c.logHandler.Exec(ctx, query, args, replacedErr, err, time.Since(t0))
 
@@ -124,6 +259,8 @@ func (c *connection) ExecContext(ctx context.Context, query string, args []drive
 		return nil, err
 	}
 
+	logExecResult(ctx, c.logHandler, query, args, result)
+
 	return &queryResult{
 		ctx:    ctx,
 		result: result,
@@ -136,14 +273,23 @@ func (c *connection) Exec(query string, args []driver.Value) (driver.Result, err
 		return nil, driver.ErrSkip
 	}
 
+	ctx := c.txContext(context.Background())
+
+	if err := c.policy.check(ctx, c.logHandler, query); err != nil {
+		return nil, err
+	}
+
 	t0 := time.Now()
 
-	result, err := connExecer.Exec(query, args)
+	result, release, err := invoke(ctx, c, OpExec, query, func() (driver.Result, error) {
+		return connExecer.Exec(query, args)
+	})
+	release()
 	var replacedErr error
 	if err != nil {
-		replacedErr = c.queryErrReplacer(err)
+		replacedErr = c.replaceErr(ctx, err)
 	}
-	c.logHandler.Exec(context.Background(), query, driverValuesToNamed(args), replacedErr, err, time.Since(t0))
+	c.logHandler.Exec(ctx, query, driverValuesToNamed(args), replacedErr, err, time.Since(t0))
 
 	if err != nil {
 		if replacedErr != nil {
@@ -152,38 +298,104 @@ func (c *connection) Exec(query string, args []driver.Value) (driver.Result, err
 		return nil, err
 	}
 
+	logExecResult(ctx, c.logHandler, query, driverValuesToNamed(args), result)
+
 	return &queryResult{
-		ctx:    context.Background(),
+		ctx:    ctx,
 		result: result,
 	}, nil
 }
 
 func (c *connection) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.ensureSession(ctx); err != nil {
+		return nil, err
+	}
+	ctx = c.txContext(ctx)
+
 	connQueryerCtx, ok := c.conn.(driver.QueryerContext)
-	if !ok {
+	if !ok && c.stmtCache == nil {
 		return c.Query(query, driverNamedToValues(args))
 	}
 
+	if err := c.policy.check(ctx, c.logHandler, query); err != nil {
+		return nil, err
+	}
+
+	var cacheKey string
+	cacheTTL, cacheable := c.resultCache.ttl(ctx, query)
+	cacheable = cacheable && !c.inTx
+	if cacheable {
+		cacheKey = resultCacheKey(query, args, c.requiredSettings(ctx))
+		if rows, hit := c.resultCache.get(ctx, c.logHandler, cacheKey, query, args); hit {
+			return &queryRows{
+				logHandler: c.logHandler,
+				connCtx:    ctx,
+				rows:       rows,
+			}, nil
+		}
+	}
+
+	ctx, op := c.registry.track(ctx, ActiveQuery, c, query, args)
+
 	t0 := time.Now()
 
-	rows, err := connQueryerCtx.QueryContext(ctx, query, args)
+	// Query timeout covers iteration over rows, so context is canceled when rows are closed
+	tctx, cancel, timeout := c.timeouts.withTimeout(ctx, OpQuery)
+
+	// Cached statement is released when rows are closed
+	releaseStmt := func() {}
+	rows, release, err := invoke(tctx, c, OpQuery, query, func() (driver.Rows, error) {
+		var rows driver.Rows
+		var err error
+		if c.stmtCache != nil {
+			var releaseRowsStmt func()
+			rows, releaseRowsStmt, err = c.queryCached(tctx, query, args)
+			if err == nil {
+				releaseStmt = releaseRowsStmt
+			}
+		} else {
+			rows, err = connQueryerCtx.QueryContext(tctx, query, args)
+		}
+
+		if err == nil && cacheable {
+			return c.resultCache.fill(cacheKey, query, args, cacheTTL, rows)
+		}
+		return rows, err
+	})
+	err = checkTimeout(ctx, tctx, c.logHandler, OpQuery, query, timeout, err)
 	var replacedErr error
 	if err != nil {
-		replacedErr = c.queryErrReplacer(err)
+		replacedErr = c.replaceErr(ctx, err)
 	}
-	c.logHandler.Query(context.Background(), query, args, replacedErr, err, time.Since(t0))
+	dt := time.Since(t0)
+	c.logHandler.Query(ctx, query, args, replacedErr, err, dt)
 
 	if err != nil {
+		cancel()
+		release()
+		op.done()
 		if replacedErr != nil {
 			return nil, replacedErr
 		}
 		return nil, err
 	}
 
+	op.setKind(ActiveRows)
+	c.explainer.observe(ctx, query, args, dt)
+
 	return &queryRows{
 		logHandler: c.logHandler,
 		connCtx:    ctx,
-		rows:       rows,
+		cancel: func() {
+			releaseStmt()
+			cancel()
+			release()
+			op.done()
+		},
+		rows:    rows,
+		query:   query,
+		tctx:    tctx,
+		timeout: timeout,
 	}, nil
 }
 
@@ -193,25 +405,38 @@ func (c *connection) Query(query string, args []driver.Value) (driver.Rows, erro
 		return nil, driver.ErrSkip
 	}
 
+	ctx := c.txContext(context.Background())
+
+	if err := c.policy.check(ctx, c.logHandler, query); err != nil {
+		return nil, err
+	}
+
 	t0 := time.Now()
 
-	rows, err := connQueryer.Query(query, args)
+	rows, release, err := invoke(ctx, c, OpQuery, query, func() (driver.Rows, error) {
+		return connQueryer.Query(query, args)
+	})
 	var replacedErr error
 	if err != nil {
-		replacedErr = c.queryErrReplacer(err)
+		replacedErr = c.replaceErr(ctx, err)
 	}
-	c.logHandler.Query(context.Background(), query, driverValuesToNamed(args), replacedErr, err, time.Since(t0))
+	dt := time.Since(t0)
+	c.logHandler.Query(ctx, query, driverValuesToNamed(args), replacedErr, err, dt)
 
 	if err != nil {
+		release()
 		if replacedErr != nil {
 			return nil, replacedErr
 		}
 		return nil, err
 	}
 
+	c.explainer.observe(ctx, query, driverValuesToNamed(args), dt)
+
 	return &queryRows{
 		logHandler: c.logHandler,
-		connCtx:    context.Background(),
+		connCtx:    ctx,
+		cancel:     release,
 		rows:       rows,
 	}, nil
 }
@@ -224,19 +449,39 @@ func (c *connection) Ping(ctx context.Context) error {
 
 	t0 := time.Now()
 
-	err := connPinger.Ping(ctx)
+	tctx, cancel, timeout := c.timeouts.withTimeout(ctx, OpPing)
+	defer cancel()
+
+	err := connPinger.Ping(tctx)
+	err = checkTimeout(ctx, tctx, c.logHandler, OpPing, "", timeout, err)
 	c.logHandler.Ping(ctx, err, time.Since(t0))
 
 	return err
 }
 
 func (c *connection) ResetSession(ctx context.Context) error {
-	connSessionResetter, ok := c.conn.(driver.SessionResetter)
-	if !ok {
-		return nil
+	c.resetSessionState()
+
+	if connSessionResetter, ok := c.conn.(driver.SessionResetter); ok {
+		t0 := time.Now()
+
+		err := connSessionResetter.ResetSession(ctx)
+		if sl, ok := c.logHandler.(SessionLogger); ok {
+			sl.ResetSession(ctx, err, time.Since(t0))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.onReset != nil {
+		// [database/sql] discards connection only if reset fails with driver.ErrBadConn
+		if err := c.onReset(ctx, c.conn); err != nil {
+			return fmt.Errorf("%w: on reset hook: %w", driver.ErrBadConn, err)
+		}
 	}
 
-	return connSessionResetter.ResetSession(ctx)
+	return nil
 }
 
 func (c *connection) IsValid() bool {
@@ -245,7 +490,14 @@ func (c *connection) IsValid() bool {
 		return true
 	}
 
-	return connValidator.IsValid()
+	valid := connValidator.IsValid()
+	if !valid {
+		if sl, ok := c.logHandler.(SessionLogger); ok {
+			sl.ConnInvalidated(context.Background())
+		}
+	}
+
+	return valid
 }
 
 func (c *connection) CheckNamedValue(value *driver.NamedValue) error {
@@ -254,5 +506,45 @@ func (c *connection) CheckNamedValue(value *driver.NamedValue) error {
 		return driver.ErrSkip
 	}
 
-	return connValueChecker.CheckNamedValue(value)
+	err := connValueChecker.CheckNamedValue(value)
+	if sl, ok := c.logHandler.(SessionLogger); ok {
+		sl.CheckNamedValue(context.Background(), *value, err)
+	}
+
+	return err
+}
+
+// invoke performs call of the driver: call is counted against budget of ctx and waits for limiter, every attempt is
+// guarded by circuit breaker, failed attempts are retried according to retry policy. Returned release function frees
+// limiter slot and must be called once call results are no longer used
+func invoke[T any](ctx context.Context, c *connection, op Op, query string, call func() (T, error)) (T, func(), error) {
+	var zero T
+
+	spend, err := chargeBudget(ctx, c, query)
+	if err != nil {
+		return zero, func() {}, err
+	}
+
+	release, err := c.limiter.acquire(ctx, c.logHandler, query)
+	if err != nil {
+		return zero, release, err
+	}
+
+	t0 := time.Now()
+
+	result, err := withRetry(ctx, c, op, query, func() (T, error) {
+		return withBreaker(ctx, c.breaker, c.logHandler, call)
+	})
+	spend(time.Since(t0), err)
+
+	return result, release, err
+}
+
+// replaceErr applies QueryErrReplacer to err unless it is skipped for errors caused by context
+func (c *connection) replaceErr(ctx context.Context, err error) error {
+	if c.skipQerOnCtxErr && ClassifyErr(ctx, err).IsContextErr() {
+		return nil
+	}
+
+	return c.queryErrReplacer(err)
 }
@@ -0,0 +1,477 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"reflect"
+)
+
+var (
+	_ driver.Connector = (*chaosConnector)(nil)
+
+	_ driver.Conn               = (*chaosConnection)(nil)
+	_ driver.ConnBeginTx        = (*chaosConnection)(nil)
+	_ driver.ConnPrepareContext = (*chaosConnection)(nil)
+	_ driver.Execer             = (*chaosConnection)(nil)
+	_ driver.ExecerContext      = (*chaosConnection)(nil)
+	_ driver.Queryer            = (*chaosConnection)(nil)
+	_ driver.QueryerContext     = (*chaosConnection)(nil)
+	_ driver.Pinger             = (*chaosConnection)(nil)
+	_ driver.SessionResetter    = (*chaosConnection)(nil)
+	_ driver.Validator          = (*chaosConnection)(nil)
+	_ driver.NamedValueChecker  = (*chaosConnection)(nil)
+
+	_ driver.Stmt              = (*chaosStatement)(nil)
+	_ driver.StmtExecContext   = (*chaosStatement)(nil)
+	_ driver.StmtQueryContext  = (*chaosStatement)(nil)
+	_ driver.NamedValueChecker = (*chaosStatement)(nil)
+	_ driver.ColumnConverter   = (*chaosStatement)(nil)
+
+	_ driver.Rows                           = (*chaosRows)(nil)
+	_ driver.RowsNextResultSet              = (*chaosRows)(nil)
+	_ driver.RowsColumnTypeScanType         = (*chaosRows)(nil)
+	_ driver.RowsColumnTypeDatabaseTypeName = (*chaosRows)(nil)
+	_ driver.RowsColumnTypeLength           = (*chaosRows)(nil)
+	_ driver.RowsColumnTypeNullable         = (*chaosRows)(nil)
+	_ driver.RowsColumnTypePrecisionScale   = (*chaosRows)(nil)
+
+	_ driver.Tx = (*chaosTransaction)(nil)
+)
+
+// NewChaosConnector returns new [driver.Connector] based on existing connector that injects faults described by cfg.
+// It is intended for resilience testing of retry and rollback code. Place it under logged connector, so injected
+// faults are logged as usual:
+//
+//	chaosConnector := logsql.NewChaosConnector(connector, logsql.ChaosConfig{
+//		Seed: 42,
+//		Faults: []logsql.Fault{
+//			{Kind: logsql.FaultConnect, Probability: 0.1, Err: errConnectionRefused},
+//			{Kind: logsql.FaultQuery, Pattern: regexp.MustCompile(`^SELECT`), Probability: 0.05, Err: driver.ErrBadConn},
+//			{Kind: logsql.FaultExec, Probability: 0.2, Latency: time.Second},
+//			{Kind: logsql.FaultCommit, Probability: 0.01, Err: errSerializationFailure},
+//		},
+//	})
+//	db := sql.OpenDB(logsql.NewConnectorFromConnector(chaosConnector, cfg))
+func NewChaosConnector(connector driver.Connector, cfg ChaosConfig) driver.Connector {
+	return &chaosConnector{
+		chaos:     newChaos(cfg),
+		connector: connector,
+	}
+}
+
+type (
+	chaosConnector struct {
+		chaos     *chaos
+		connector driver.Connector
+	}
+
+	chaosConnection struct {
+		chaos *chaos
+		conn  driver.Conn
+	}
+
+	chaosStatement struct {
+		chaos     *chaos
+		query     string
+		statement driver.Stmt
+	}
+
+	chaosRows struct {
+		chaos *chaos
+		query string
+		rows  driver.Rows
+	}
+
+	chaosTransaction struct {
+		chaos       *chaos
+		connCtx     context.Context
+		transaction driver.Tx
+	}
+)
+
+func (c *chaosConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	if err := c.chaos.inject(ctx, FaultConnect, ""); err != nil {
+		return nil, err
+	}
+
+	conn, err := c.connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chaosConnection{
+		chaos: c.chaos,
+		conn:  conn,
+	}, nil
+}
+
+func (c *chaosConnector) Driver() driver.Driver {
+	return c.connector.Driver()
+}
+
+func (c *chaosConnection) Prepare(query string) (driver.Stmt, error) {
+	if err := c.chaos.inject(context.Background(), FaultPrepare, query); err != nil {
+		return nil, err
+	}
+
+	stmt, err := c.conn.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chaosStatement{
+		chaos:     c.chaos,
+		query:     query,
+		statement: stmt,
+	}, nil
+}
+
+func (c *chaosConnection) Close() error {
+	return c.conn.Close()
+}
+
+func (c *chaosConnection) Begin() (driver.Tx, error) {
+	if err := c.chaos.inject(context.Background(), FaultBegin, ""); err != nil {
+		return nil, err
+	}
+
+	tx, err := c.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	return &chaosTransaction{
+		chaos:       c.chaos,
+		connCtx:     context.Background(),
+		transaction: tx,
+	}, nil
+}
+
+func (c *chaosConnection) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	connBeginTx, ok := c.conn.(driver.ConnBeginTx)
+	if !ok {
+		return c.Begin()
+	}
+
+	if err := c.chaos.inject(ctx, FaultBegin, ""); err != nil {
+		return nil, err
+	}
+
+	tx, err := connBeginTx.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chaosTransaction{
+		chaos:       c.chaos,
+		connCtx:     ctx,
+		transaction: tx,
+	}, nil
+}
+
+func (c *chaosConnection) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	connPrepareCtx, ok := c.conn.(driver.ConnPrepareContext)
+	if !ok {
+		return c.Prepare(query)
+	}
+
+	if err := c.chaos.inject(ctx, FaultPrepare, query); err != nil {
+		return nil, err
+	}
+
+	stmt, err := connPrepareCtx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chaosStatement{
+		chaos:     c.chaos,
+		query:     query,
+		statement: stmt,
+	}, nil
+}
+
+func (c *chaosConnection) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	connExecerCtx, ok := c.conn.(driver.ExecerContext)
+	if !ok {
+		return c.Exec(query, driverNamedToValues(args))
+	}
+
+	if err := c.chaos.inject(ctx, FaultExec, query); err != nil {
+		return nil, err
+	}
+
+	return connExecerCtx.ExecContext(ctx, query, args)
+}
+
+func (c *chaosConnection) Exec(query string, args []driver.Value) (driver.Result, error) {
+	connExecer, ok := c.conn.(driver.Execer)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	if err := c.chaos.inject(context.Background(), FaultExec, query); err != nil {
+		return nil, err
+	}
+
+	return connExecer.Exec(query, args)
+}
+
+func (c *chaosConnection) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	connQueryerCtx, ok := c.conn.(driver.QueryerContext)
+	if !ok {
+		return c.Query(query, driverNamedToValues(args))
+	}
+
+	if err := c.chaos.inject(ctx, FaultQuery, query); err != nil {
+		return nil, err
+	}
+
+	rows, err := connQueryerCtx.QueryContext(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chaosRows{
+		chaos: c.chaos,
+		query: query,
+		rows:  rows,
+	}, nil
+}
+
+func (c *chaosConnection) Query(query string, args []driver.Value) (driver.Rows, error) {
+	connQueryer, ok := c.conn.(driver.Queryer)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	if err := c.chaos.inject(context.Background(), FaultQuery, query); err != nil {
+		return nil, err
+	}
+
+	rows, err := connQueryer.Query(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chaosRows{
+		chaos: c.chaos,
+		query: query,
+		rows:  rows,
+	}, nil
+}
+
+func (c *chaosConnection) Ping(ctx context.Context) error {
+	connPinger, ok := c.conn.(driver.Pinger)
+	if !ok {
+		return ErrUnsupportedByDriver
+	}
+
+	return connPinger.Ping(ctx)
+}
+
+func (c *chaosConnection) ResetSession(ctx context.Context) error {
+	connSessionResetter, ok := c.conn.(driver.SessionResetter)
+	if !ok {
+		return nil
+	}
+
+	return connSessionResetter.ResetSession(ctx)
+}
+
+func (c *chaosConnection) IsValid() bool {
+	connValidator, ok := c.conn.(driver.Validator)
+	if !ok {
+		return true
+	}
+
+	return connValidator.IsValid()
+}
+
+func (c *chaosConnection) CheckNamedValue(value *driver.NamedValue) error {
+	connValueChecker, ok := c.conn.(driver.NamedValueChecker)
+	if !ok {
+		return driver.ErrSkip
+	}
+
+	return connValueChecker.CheckNamedValue(value)
+}
+
+func (s *chaosStatement) Close() error {
+	return s.statement.Close()
+}
+
+func (s *chaosStatement) NumInput() int {
+	return s.statement.NumInput()
+}
+
+func (s *chaosStatement) Exec(args []driver.Value) (driver.Result, error) {
+	if err := s.chaos.inject(context.Background(), FaultExec, s.query); err != nil {
+		return nil, err
+	}
+
+	return s.statement.Exec(args)
+}
+
+func (s *chaosStatement) Query(args []driver.Value) (driver.Rows, error) {
+	if err := s.chaos.inject(context.Background(), FaultQuery, s.query); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.statement.Query(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chaosRows{
+		chaos: s.chaos,
+		query: s.query,
+		rows:  rows,
+	}, nil
+}
+
+func (s *chaosStatement) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	stExecerCtx, ok := s.statement.(driver.StmtExecContext)
+	if !ok {
+		return s.Exec(driverNamedToValues(args))
+	}
+
+	if err := s.chaos.inject(ctx, FaultExec, s.query); err != nil {
+		return nil, err
+	}
+
+	return stExecerCtx.ExecContext(ctx, args)
+}
+
+func (s *chaosStatement) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	stQueryerCtx, ok := s.statement.(driver.StmtQueryContext)
+	if !ok {
+		return s.Query(driverNamedToValues(args))
+	}
+
+	if err := s.chaos.inject(ctx, FaultQuery, s.query); err != nil {
+		return nil, err
+	}
+
+	rows, err := stQueryerCtx.QueryContext(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chaosRows{
+		chaos: s.chaos,
+		query: s.query,
+		rows:  rows,
+	}, nil
+}
+
+func (s *chaosStatement) CheckNamedValue(value *driver.NamedValue) error {
+	stValueChecker, ok := s.statement.(driver.NamedValueChecker)
+	if !ok {
+		return driver.ErrSkip
+	}
+
+	return stValueChecker.CheckNamedValue(value)
+}
+
+func (s *chaosStatement) ColumnConverter(idx int) driver.ValueConverter {
+	stColumnConverter, ok := s.statement.(driver.ColumnConverter)
+	if !ok {
+		return driver.DefaultParameterConverter
+	}
+
+	return stColumnConverter.ColumnConverter(idx)
+}
+
+func (r *chaosRows) Columns() []string {
+	return r.rows.Columns()
+}
+
+func (r *chaosRows) Close() error {
+	return r.rows.Close()
+}
+
+func (r *chaosRows) Next(dest []driver.Value) error {
+	if err := r.chaos.inject(context.Background(), FaultRowsNext, r.query); err != nil {
+		return err
+	}
+
+	return r.rows.Next(dest)
+}
+
+func (r *chaosRows) HasNextResultSet() bool {
+	rs, ok := r.rows.(driver.RowsNextResultSet)
+	if !ok {
+		return false
+	}
+
+	return rs.HasNextResultSet()
+}
+
+func (r *chaosRows) NextResultSet() error {
+	rs, ok := r.rows.(driver.RowsNextResultSet)
+	if !ok {
+		return io.EOF
+	}
+
+	return rs.NextResultSet()
+}
+
+func (r *chaosRows) ColumnTypeScanType(index int) reflect.Type {
+	rs, ok := r.rows.(driver.RowsColumnTypeScanType)
+	if !ok {
+		return reflect.TypeFor[any]()
+	}
+
+	return rs.ColumnTypeScanType(index)
+}
+
+func (r *chaosRows) ColumnTypeDatabaseTypeName(index int) string {
+	rs, ok := r.rows.(driver.RowsColumnTypeDatabaseTypeName)
+	if !ok {
+		return ""
+	}
+
+	return rs.ColumnTypeDatabaseTypeName(index)
+}
+
+func (r *chaosRows) ColumnTypeLength(index int) (int64, bool) {
+	rs, ok := r.rows.(driver.RowsColumnTypeLength)
+	if !ok {
+		return 0, false
+	}
+
+	return rs.ColumnTypeLength(index)
+}
+
+func (r *chaosRows) ColumnTypeNullable(index int) (bool, bool) {
+	rs, ok := r.rows.(driver.RowsColumnTypeNullable)
+	if !ok {
+		return false, false
+	}
+
+	return rs.ColumnTypeNullable(index)
+}
+
+func (r *chaosRows) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	rs, ok := r.rows.(driver.RowsColumnTypePrecisionScale)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return rs.ColumnTypePrecisionScale(index)
+}
+
+func (t *chaosTransaction) Commit() error {
+	if err := t.chaos.inject(t.connCtx, FaultCommit, ""); err != nil {
+		_ = t.transaction.Rollback()
+		return err
+	}
+
+	return t.transaction.Commit()
+}
+
+func (t *chaosTransaction) Rollback() error {
+	return t.transaction.Rollback()
+}
@@ -0,0 +1,229 @@
+package logsql
+
+import (
+	"cmp"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	_ driver.Connector = (*failoverConnector)(nil)
+	_ driver.Connector = (*dsnConnector)(nil)
+)
+
+type (
+	// FailoverHost is a candidate of failover connector. Name identifies host in Logger events, it must not contain
+	// credentials. Hosts with lower Priority are tried first, hosts with equal Priority are tried in order of the list
+	FailoverHost struct {
+		Name      string
+		Connector driver.Connector
+		Priority  int
+	}
+
+	// FailoverConfig configures backoff of failing hosts. After n-th consecutive failure host is tried only after
+	// all other hosts during InitialBackoff*2^(n-1) limited by MaxBackoff. Zero InitialBackoff and MaxBackoff are
+	// replaced by 1 second and 1 minute respectively
+	FailoverConfig struct {
+		InitialBackoff time.Duration
+		MaxBackoff     time.Duration
+	}
+
+	// FailoverLogger can be optionally implemented by Logger to log failover events
+	FailoverLogger interface {
+		// HostDown is called when connect to host fails, host is backed off for backoff
+		HostDown(ctx context.Context, host string, err error, backoff time.Duration)
+		// Failover is called when connection is established to host other than the last good one. from is empty
+		// for the first connection
+		Failover(ctx context.Context, from string, to string)
+	}
+
+	failoverConnector struct {
+		cfg        FailoverConfig
+		logHandler Logger
+		hosts      []*failoverHost
+
+		mu       sync.Mutex
+		lastGood *failoverHost
+	}
+
+	failoverHost struct {
+		name      string
+		priority  int
+		connector driver.Connector
+
+		// failures and backoffUntil are guarded by mutex of connector
+		failures     int
+		backoffUntil time.Time
+	}
+
+	dsnConnector struct {
+		drv driver.Driver
+		dsn string
+	}
+)
+
+// NewFailoverConnector returns new [driver.Connector] that connects to the first available host. Each of host
+// connectors is wrapped as by NewConnectorFromConnector with cfg, except that each of them gets its own
+// CircuitBreaker configured as cfg.Breaker, so failures of one host do not open breaker of others. Panics if hosts
+// are empty or [Config.Validate] returns non-nil error.
+//
+// The last good host is tried first, then healthy hosts by priority and finally hosts that are backed off after
+// failures. Host name is added to context of Connect events, see TargetFromContext
+func NewFailoverConnector(hosts []FailoverHost, foCfg FailoverConfig, cfg Config) driver.Connector {
+	if len(hosts) == 0 {
+		panic(ErrNoHosts)
+	}
+
+	if foCfg.InitialBackoff <= 0 {
+		foCfg.InitialBackoff = time.Second
+	}
+	if foCfg.MaxBackoff <= 0 {
+		foCfg.MaxBackoff = time.Minute
+	}
+
+	c := &failoverConnector{
+		cfg:        foCfg,
+		logHandler: cfg.LogHandler,
+		hosts:      make([]*failoverHost, len(hosts)),
+	}
+	for i, host := range hosts {
+		c.hosts[i] = &failoverHost{
+			name:      host.Name,
+			priority:  host.Priority,
+			connector: NewConnectorFromConnector(host.Connector, cfg.withOwnBreaker()),
+		}
+	}
+
+	return c
+}
+
+// NewFailoverConnectorFromDSNs returns failover connector over hosts defined by DSNs of the driver in order of
+// priority. Host of URL-formatted DSN is used as host name, other hosts are named "host-0", "host-1" and so on
+func NewFailoverConnectorFromDSNs(d driver.Driver, dsns []string, foCfg FailoverConfig, cfg Config) driver.Connector {
+	hosts := make([]FailoverHost, len(dsns))
+	for i, dsn := range dsns {
+		name := fmt.Sprintf("host-%d", i)
+		if u, err := url.Parse(dsn); err == nil && u.Host != "" {
+			name = u.Host
+		}
+
+		hosts[i] = FailoverHost{
+			Name:      name,
+			Connector: &dsnConnector{drv: d, dsn: dsn},
+			Priority:  i,
+		}
+	}
+
+	return NewFailoverConnector(hosts, foCfg, cfg)
+}
+
+func (c *failoverConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	var errs []error
+	for _, host := range c.candidates() {
+		if ctx.Err() != nil {
+			break
+		}
+
+		conn, err := host.connector.Connect(withTarget(ctx, host.name))
+		if err != nil {
+			c.fail(ctx, host, err)
+			errs = append(errs, fmt.Errorf("%s: %w", host.name, err))
+			continue
+		}
+
+		c.succeed(ctx, host)
+		return conn, nil
+	}
+
+	if len(errs) == 0 {
+		return nil, ctx.Err()
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+func (c *failoverConnector) Driver() driver.Driver {
+	return c.hosts[0].connector.Driver()
+}
+
+// candidates returns hosts in order of connection attempts
+func (c *failoverConnector) candidates() []*failoverHost {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	rank := func(h *failoverHost) int {
+		switch {
+		case now.Before(h.backoffUntil):
+			return 2
+		case h == c.lastGood:
+			return 0
+		default:
+			return 1
+		}
+	}
+
+	hosts := slices.Clone(c.hosts)
+	slices.SortStableFunc(hosts, func(a *failoverHost, b *failoverHost) int {
+		return cmp.Or(cmp.Compare(rank(a), rank(b)), cmp.Compare(a.priority, b.priority))
+	})
+
+	return hosts
+}
+
+func (c *failoverConnector) fail(ctx context.Context, host *failoverHost, err error) {
+	c.mu.Lock()
+	host.failures++
+	backoff := c.cfg.MaxBackoff
+	if host.failures < 32 {
+		backoff = min(c.cfg.InitialBackoff<<(host.failures-1), c.cfg.MaxBackoff)
+	}
+	host.backoffUntil = time.Now().Add(backoff)
+	c.mu.Unlock()
+
+	if fl, ok := c.logHandler.(FailoverLogger); ok {
+		fl.HostDown(withTarget(ctx, host.name), host.name, err, backoff)
+	}
+}
+
+func (c *failoverConnector) succeed(ctx context.Context, host *failoverHost) {
+	c.mu.Lock()
+	host.failures, host.backoffUntil = 0, time.Time{}
+	previous := c.lastGood
+	c.lastGood = host
+	c.mu.Unlock()
+
+	if previous == host {
+		return
+	}
+
+	if fl, ok := c.logHandler.(FailoverLogger); ok {
+		var from string
+		if previous != nil {
+			from = previous.name
+		}
+		fl.Failover(withTarget(ctx, host.name), from, host.name)
+	}
+}
+
+func (c *dsnConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	if driverCtx, ok := c.drv.(driver.DriverContext); ok {
+		connector, err := driverCtx.OpenConnector(c.dsn)
+		if err != nil {
+			return nil, err
+		}
+		return connector.Connect(ctx)
+	}
+
+	return c.drv.Open(c.dsn)
+}
+
+func (c *dsnConnector) Driver() driver.Driver {
+	return c.drv
+}
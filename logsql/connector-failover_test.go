@@ -0,0 +1,31 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+func TestFailoverConnectorBreakers(t *testing.T) {
+	down := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Connect:  fakedriver.Outcome{Err: driver.ErrBadConn},
+	})
+	up := fakedriver.New(fakedriver.Config{Features: fakedriver.FeatureAll})
+
+	db := sql.OpenDB(NewFailoverConnector([]FailoverHost{
+		{Name: "down", Connector: down},
+		{Name: "up", Connector: up},
+	}, FailoverConfig{}, Config{
+		LogHandler: &countingLogger{events: make(map[string]int)},
+		Breaker:    NewCircuitBreaker(CircuitBreakerConfig{Threshold: 1}),
+	}))
+	defer db.Close()
+
+	if _, err := db.ExecContext(context.Background(), "UPDATE t SET a = 1"); err != nil {
+		t.Errorf("expected failures of one host not to open breaker of others, got %v", err)
+	}
+}
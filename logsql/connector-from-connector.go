@@ -6,6 +6,10 @@ import (
 	"time"
 )
 
+var (
+	_ driver.Connector = (*connectorFromConnector)(nil)
+)
+
 // NewConnectorFromConnector returns new [driver.Connector] based on existing connector. Panics if [Config.Validate]
 // returns non nil error
 func NewConnectorFromConnector(connector driver.Connector, cfg Config) driver.Connector {
@@ -13,40 +17,44 @@ func NewConnectorFromConnector(connector driver.Connector, cfg Config) driver.Co
 		panic(err)
 	}
 
-	if cfg.Qer == nil {
-		cfg.Qer = NoOpQueryErrReplacer
-	}
+	cfg = cfg.withDefaults()
 
 	return &connectorFromConnector{
-		logHandler:       cfg.LogHandler,
-		queryErrReplacer: cfg.Qer,
-		connector:        connector,
+		cfg:       cfg,
+		connector: connector,
+		explainer: newExplainer(cfg, connector.Connect),
 	}
 }
 
 type (
 	connectorFromConnector struct {
-		logHandler       Logger
-		queryErrReplacer QueryErrReplacer
+		cfg Config
 
 		connector driver.Connector
+		explainer *explainer
 	}
 )
 
 func (c *connectorFromConnector) Connect(ctx context.Context) (driver.Conn, error) {
 	t0 := time.Now()
 
-	conn, err := c.connector.Connect(ctx)
-	c.logHandler.Connect(ctx, err, time.Since(t0))
+	tctx, cancel, timeout := c.cfg.Timeouts.withTimeout(ctx, OpConnect)
+	defer cancel()
+
+	conn, err := withBreaker(ctx, c.cfg.Breaker, c.cfg.LogHandler, func() (driver.Conn, error) {
+		return c.connector.Connect(tctx)
+	})
+	var lconn *connection
+	if err == nil {
+		lconn, err = openConnection(tctx, c.cfg, c.explainer, conn)
+	}
+	err = checkTimeout(ctx, tctx, c.cfg.LogHandler, OpConnect, "", timeout, err)
+	c.cfg.LogHandler.Connect(ctx, err, time.Since(t0))
 	if err != nil {
 		return nil, err
 	}
 
-	return &connection{
-		logHandler:       c.logHandler,
-		queryErrReplacer: c.queryErrReplacer,
-		conn:             conn,
-	}, nil
+	return lconn, nil
 }
 
 func (c *connectorFromConnector) Driver() driver.Driver {
@@ -6,6 +6,10 @@ import (
 	"time"
 )
 
+var (
+	_ driver.Connector = (*connectorFromDriver)(nil)
+)
+
 // NewConnectorFromDriver returns new [driver.Connector] based on an existing driver and DSN. Panics if [Config.Validate]
 // returns non-nil error
 func NewConnectorFromDriver(d driver.Driver, dsn string, cfg Config) driver.Connector {
@@ -13,42 +17,44 @@ func NewConnectorFromDriver(d driver.Driver, dsn string, cfg Config) driver.Conn
 		panic(err)
 	}
 
-	if cfg.Qer == nil {
-		cfg.Qer = NoOpQueryErrReplacer
-	}
+	cfg = cfg.withDefaults()
 
 	return &connectorFromDriver{
-		logHandler:       cfg.LogHandler,
-		queryErrReplacer: cfg.Qer,
-		drv:              d,
-		dsn:              dsn,
+		cfg: cfg,
+		drv: d,
+		dsn: dsn,
+		explainer: newExplainer(cfg, func(context.Context) (driver.Conn, error) {
+			return d.Open(dsn)
+		}),
 	}
 }
 
 type (
 	connectorFromDriver struct {
-		logHandler       Logger
-		queryErrReplacer QueryErrReplacer
+		cfg Config
 
-		drv driver.Driver
-		dsn string
+		drv       driver.Driver
+		dsn       string
+		explainer *explainer
 	}
 )
 
 func (c *connectorFromDriver) Connect(ctx context.Context) (driver.Conn, error) {
 	t0 := time.Now()
 
-	conn, err := c.drv.Open(c.dsn)
-	c.logHandler.Connect(ctx, err, time.Since(t0))
+	conn, err := withBreaker(ctx, c.cfg.Breaker, c.cfg.LogHandler, func() (driver.Conn, error) {
+		return c.drv.Open(c.dsn)
+	})
+	var lconn *connection
+	if err == nil {
+		lconn, err = openConnection(ctx, c.cfg, c.explainer, conn)
+	}
+	c.cfg.LogHandler.Connect(ctx, err, time.Since(t0))
 	if err != nil {
 		return nil, err
 	}
 
-	return &connection{
-		logHandler:       c.logHandler,
-		queryErrReplacer: c.queryErrReplacer,
-		conn:             conn,
-	}, nil
+	return lconn, nil
 }
 
 func (c *connectorFromDriver) Driver() driver.Driver {
@@ -0,0 +1,462 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// ReplicaRoundRobin selects healthy replicas in turn
+	ReplicaRoundRobin ReplicaSelection = iota
+	// ReplicaLeastLatency selects healthy replica with the least latency of connect and ping
+	ReplicaLeastLatency
+)
+
+const (
+	// TargetPrimary is a target of operations routed to primary by read/write connector
+	TargetPrimary = "primary"
+)
+
+var (
+	_ driver.Connector = (*readWriteConnector)(nil)
+
+	_ driver.Conn               = (*readWriteConnection)(nil)
+	_ driver.ConnBeginTx        = (*readWriteConnection)(nil)
+	_ driver.ConnPrepareContext = (*readWriteConnection)(nil)
+	_ driver.ExecerContext      = (*readWriteConnection)(nil)
+	_ driver.QueryerContext     = (*readWriteConnection)(nil)
+	_ driver.Pinger             = (*readWriteConnection)(nil)
+	_ driver.SessionResetter    = (*readWriteConnection)(nil)
+	_ driver.Validator          = (*readWriteConnection)(nil)
+	_ driver.NamedValueChecker  = (*readWriteConnection)(nil)
+
+	_ driver.Tx = (*readWriteTransaction)(nil)
+)
+
+type (
+	// ReplicaSelection is a strategy of choosing replica for reads
+	ReplicaSelection uint8
+
+	// ReadWriteConfig configures routing of read/write connector. Replica is considered unhealthy and is not used for
+	// UnhealthyTimeout after failed connect or ping, zero UnhealthyTimeout is replaced by 5 seconds
+	ReadWriteConfig struct {
+		Selection        ReplicaSelection
+		UnhealthyTimeout time.Duration
+	}
+
+	// ReplicaLogger can be optionally implemented by Logger to log changes of replica health. err is nil if replica
+	// became healthy
+	ReplicaLogger interface {
+		ReplicaHealth(ctx context.Context, target string, healthy bool, err error)
+	}
+
+	readWriteConnector struct {
+		cfg        ReadWriteConfig
+		logHandler Logger
+		primary    driver.Connector
+		replicas   []*replica
+		next       atomic.Uint64
+	}
+
+	replica struct {
+		target    string
+		connector driver.Connector
+
+		mu             sync.Mutex
+		unhealthyUntil time.Time
+		latency        time.Duration
+	}
+
+	readWriteConnection struct {
+		connector *readWriteConnector
+
+		primary *connection
+		replica *connection
+		target  *replica
+		// open counts statements and rows of connections to replicas that are not closed yet. dropped are
+		// connections to replicas removed from rotation while some of them were open, every such connection is
+		// closed once all of them are closed
+		open    map[*connection]int
+		dropped []*connection
+
+		// txConn and txTarget are set during transaction, so all its operations use the same connection
+		txConn   *connection
+		txTarget string
+	}
+
+	readWriteTransaction struct {
+		conn *readWriteConnection
+		tx   driver.Tx
+	}
+)
+
+// NewReadWriteConnector returns new [driver.Connector] that routes operations between primary and replicas. Each
+// of underlying connectors is wrapped as by NewConnectorFromConnector with cfg, except that each of them gets its
+// own CircuitBreaker configured as cfg.Breaker, so failures of one target do not open breaker of others. Panics if
+// [Config.Validate] returns non-nil error.
+//
+// Read-only transactions ([driver.TxOptions] ReadOnly) and operations with context marked by WithReplicaRead are
+// routed to a replica, everything else is routed to primary. Connection to replica is opened lazily on the first
+// read, if all replicas are unhealthy, reads are routed to primary. Target is added to context of all Logger events,
+// see TargetFromContext. Replicas are named "replica-0", "replica-1" and so on
+func NewReadWriteConnector(primary driver.Connector, replicas []driver.Connector, rwCfg ReadWriteConfig,
+	cfg Config) driver.Connector {
+	if rwCfg.UnhealthyTimeout <= 0 {
+		rwCfg.UnhealthyTimeout = 5 * time.Second
+	}
+
+	c := &readWriteConnector{
+		cfg:        rwCfg,
+		logHandler: cfg.LogHandler,
+		primary:    NewConnectorFromConnector(primary, cfg.withOwnBreaker()),
+		replicas:   make([]*replica, len(replicas)),
+	}
+	for i, connector := range replicas {
+		c.replicas[i] = &replica{
+			target:    fmt.Sprintf("replica-%d", i),
+			connector: NewConnectorFromConnector(connector, cfg.withOwnBreaker()),
+		}
+	}
+
+	return c
+}
+
+// WithReplicaRead marks ctx, so operations with it outside transactions are routed to replica by read/write connector
+func WithReplicaRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKeyReplicaRead, true)
+}
+
+// TargetFromContext returns target added to ctx of Logger event by routing connectors: TargetPrimary or name of
+// replica for read/write connector, host name for failover connector. ok is false if event was not routed
+func TargetFromContext(ctx context.Context) (target string, ok bool) {
+	target, ok = ctx.Value(ctxKeyTarget).(string)
+	return target, ok
+}
+
+func withTarget(ctx context.Context, target string) context.Context {
+	return context.WithValue(ctx, ctxKeyTarget, target)
+}
+
+func isReplicaRead(ctx context.Context) bool {
+	return ctx.Value(ctxKeyReplicaRead) != nil
+}
+
+func (c *readWriteConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.primary.Connect(withTarget(ctx, TargetPrimary))
+	if err != nil {
+		return nil, err
+	}
+
+	return &readWriteConnection{
+		connector: c,
+		primary:   conn.(*connection),
+		open:      make(map[*connection]int),
+	}, nil
+}
+
+func (c *readWriteConnector) Driver() driver.Driver {
+	return c.primary.Driver()
+}
+
+// selectReplica returns healthy replica that is not in tried, nil if there is no such replica
+func (c *readWriteConnector) selectReplica(tried []*replica) *replica {
+	now := time.Now()
+	isCandidate := func(r *replica) bool {
+		for _, t := range tried {
+			if t == r {
+				return false
+			}
+		}
+		healthy, _ := r.state(now)
+		return healthy
+	}
+
+	switch c.cfg.Selection {
+	case ReplicaLeastLatency:
+		var best *replica
+		var bestLatency time.Duration
+		for _, r := range c.replicas {
+			if !isCandidate(r) {
+				continue
+			}
+			if _, latency := r.state(now); best == nil || latency < bestLatency {
+				best, bestLatency = r, latency
+			}
+		}
+		return best
+	default:
+		n := uint64(len(c.replicas))
+		start := c.next.Add(1) - 1
+		for i := uint64(0); i < n; i++ {
+			if r := c.replicas[(start+i)%n]; isCandidate(r) {
+				return r
+			}
+		}
+		return nil
+	}
+}
+
+// markUnhealthy excludes replica from selection for UnhealthyTimeout
+func (c *readWriteConnector) markUnhealthy(ctx context.Context, r *replica, err error) {
+	r.mu.Lock()
+	wasHealthy := r.unhealthyUntil.IsZero()
+	r.unhealthyUntil = time.Now().Add(c.cfg.UnhealthyTimeout)
+	r.mu.Unlock()
+
+	if rl, ok := c.logHandler.(ReplicaLogger); ok && wasHealthy {
+		rl.ReplicaHealth(ctx, r.target, false, err)
+	}
+}
+
+// markHealthy records latency of successful connect or ping of replica
+func (c *readWriteConnector) markHealthy(ctx context.Context, r *replica, latency time.Duration) {
+	r.mu.Lock()
+	wasHealthy := r.unhealthyUntil.IsZero()
+	r.unhealthyUntil = time.Time{}
+	if r.latency == 0 {
+		r.latency = latency
+	} else {
+		r.latency += (latency - r.latency) / 4
+	}
+	r.mu.Unlock()
+
+	if rl, ok := c.logHandler.(ReplicaLogger); ok && !wasHealthy {
+		rl.ReplicaHealth(ctx, r.target, true, nil)
+	}
+}
+
+// state reports whether replica can be used and returns its latency. Replica that was unhealthy gets a chance
+// after UnhealthyTimeout
+func (r *replica) state(now time.Time) (healthy bool, latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return now.After(r.unhealthyUntil), r.latency
+}
+
+// route returns connection and context with target for operation
+func (c *readWriteConnection) route(ctx context.Context, read bool) (*connection, context.Context) {
+	if c.txConn != nil {
+		return c.txConn, withTarget(ctx, c.txTarget)
+	}
+
+	if read {
+		if conn := c.replicaConn(ctx); conn != nil {
+			return conn, withTarget(ctx, c.target.target)
+		}
+	}
+
+	return c.primary, withTarget(ctx, TargetPrimary)
+}
+
+// replicaConn returns connection to replica opening it if necessary, nil if all replicas are unhealthy
+func (c *readWriteConnection) replicaConn(ctx context.Context) *connection {
+	if c.replica != nil {
+		return c.replica
+	}
+
+	var tried []*replica
+	for r := c.connector.selectReplica(nil); r != nil; r = c.connector.selectReplica(tried) {
+		t0 := time.Now()
+
+		rctx := withTarget(ctx, r.target)
+		conn, err := r.connector.Connect(rctx)
+		if err != nil {
+			c.connector.markUnhealthy(rctx, r, err)
+			tried = append(tried, r)
+			continue
+		}
+
+		c.connector.markHealthy(rctx, r, time.Since(t0))
+		c.replica, c.target = conn.(*connection), r
+		return c.replica
+	}
+
+	return nil
+}
+
+// dropReplica removes connection to replica from rotation, so the next read opens connection to another one
+func (c *readWriteConnection) dropReplica(ctx context.Context, err error) {
+	if c.replica == nil {
+		return
+	}
+
+	c.connector.markUnhealthy(withTarget(ctx, c.target.target), c.target, err)
+	if c.open[c.replica] > 0 {
+		c.dropped = append(c.dropped, c.replica)
+	} else {
+		_ = c.replica.Close()
+	}
+	c.replica, c.target = nil, nil
+}
+
+// hold counts statement or rows opened by conn, returned function must be called once they are closed. Connections
+// to primary are not counted. [database/sql] serializes calls of the connection and its statements and rows, so no
+// locking is needed
+func (c *readWriteConnection) hold(conn *connection) func() {
+	if conn == c.primary {
+		return nil
+	}
+
+	c.open[conn]++
+
+	var released bool
+	return func() {
+		if released {
+			return
+		}
+		released = true
+
+		c.open[conn]--
+		if c.open[conn] > 0 {
+			return
+		}
+		delete(c.open, conn)
+
+		if i := slices.Index(c.dropped, conn); i >= 0 {
+			c.dropped = slices.Delete(c.dropped, i, i+1)
+			_ = conn.Close()
+		}
+	}
+}
+
+// checkReplicaErr marks replica unhealthy if err means that connection to it is broken
+func (c *readWriteConnection) checkReplicaErr(ctx context.Context, conn *connection, err error) {
+	if conn == c.replica && conn != c.txConn && errors.Is(err, driver.ErrBadConn) {
+		c.dropReplica(ctx, err)
+	}
+}
+
+func (c *readWriteConnection) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *readWriteConnection) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	conn, rctx := c.route(ctx, isReplicaRead(ctx))
+
+	stmt, err := conn.PrepareContext(rctx, query)
+	c.checkReplicaErr(ctx, conn, err)
+	if qs, ok := stmt.(*queryStatement); ok {
+		qs.onClose = c.hold(conn)
+	}
+
+	return stmt, err
+}
+
+func (c *readWriteConnection) Close() error {
+	errs := []error{c.primary.Close()}
+	if c.replica != nil {
+		errs = append(errs, c.replica.Close())
+	}
+	for _, conn := range c.dropped {
+		errs = append(errs, conn.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *readWriteConnection) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *readWriteConnection) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	conn, rctx := c.route(ctx, opts.ReadOnly)
+
+	tx, err := conn.BeginTx(rctx, opts)
+	if err != nil {
+		c.checkReplicaErr(ctx, conn, err)
+		return nil, err
+	}
+
+	c.txConn = conn
+	c.txTarget, _ = TargetFromContext(rctx)
+
+	return &readWriteTransaction{
+		conn: c,
+		tx:   tx,
+	}, nil
+}
+
+func (c *readWriteConnection) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	conn, rctx := c.route(ctx, isReplicaRead(ctx))
+
+	result, err := conn.ExecContext(rctx, query, args)
+	c.checkReplicaErr(ctx, conn, err)
+
+	return result, err
+}
+
+func (c *readWriteConnection) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	conn, rctx := c.route(ctx, isReplicaRead(ctx))
+
+	rows, err := conn.QueryContext(rctx, query, args)
+	c.checkReplicaErr(ctx, conn, err)
+	if qr, ok := rows.(*queryRows); ok {
+		qr.onClose = c.hold(conn)
+	}
+
+	return rows, err
+}
+
+// Ping pings primary and opened connection to replica. Failure of replica does not fail ping, connection to
+// replica is removed from rotation instead, so the next read uses another healthy replica
+func (c *readWriteConnection) Ping(ctx context.Context) error {
+	if err := c.primary.Ping(withTarget(ctx, TargetPrimary)); err != nil {
+		return err
+	}
+
+	if c.replica != nil && c.txConn == nil {
+		t0 := time.Now()
+
+		rctx := withTarget(ctx, c.target.target)
+		if err := c.replica.Ping(rctx); err != nil {
+			c.dropReplica(ctx, err)
+		} else {
+			c.connector.markHealthy(rctx, c.target, time.Since(t0))
+		}
+	}
+
+	return nil
+}
+
+func (c *readWriteConnection) ResetSession(ctx context.Context) error {
+	if err := c.primary.ResetSession(withTarget(ctx, TargetPrimary)); err != nil {
+		return err
+	}
+
+	if c.replica != nil {
+		if err := c.replica.ResetSession(withTarget(ctx, c.target.target)); err != nil {
+			c.dropReplica(ctx, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *readWriteConnection) IsValid() bool {
+	if c.replica != nil && !c.replica.IsValid() {
+		c.dropReplica(context.Background(), driver.ErrBadConn)
+	}
+
+	return c.primary.IsValid()
+}
+
+func (c *readWriteConnection) CheckNamedValue(value *driver.NamedValue) error {
+	return c.primary.CheckNamedValue(value)
+}
+
+func (t *readWriteTransaction) Commit() error {
+	t.conn.txConn = nil
+	return t.tx.Commit()
+}
+
+func (t *readWriteTransaction) Rollback() error {
+	t.conn.txConn = nil
+	return t.tx.Rollback()
+}
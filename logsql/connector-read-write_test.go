@@ -0,0 +1,130 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+func TestReadWriteConnectorBreakers(t *testing.T) {
+	primary := fakedriver.New(fakedriver.Config{Features: fakedriver.FeatureAll})
+	replica := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Connect:  fakedriver.Outcome{Err: driver.ErrBadConn},
+	})
+
+	db := sql.OpenDB(NewReadWriteConnector(primary, []driver.Connector{replica}, ReadWriteConfig{}, Config{
+		LogHandler: &countingLogger{events: make(map[string]int)},
+		Breaker:    NewCircuitBreaker(CircuitBreakerConfig{Threshold: 1}),
+	}))
+	defer db.Close()
+
+	if _, err := db.ExecContext(WithReplicaRead(context.Background()), "SELECT 1"); err != nil {
+		t.Fatalf("expected read to fall back to primary, got %v", err)
+	}
+	if _, err := db.ExecContext(context.Background(), "UPDATE t SET a = 1"); err != nil {
+		t.Errorf("expected failures of replica not to open breaker of primary, got %v", err)
+	}
+}
+
+func TestReadWriteConnectionDropReplica(t *testing.T) {
+	primary := fakedriver.New(fakedriver.Config{Features: fakedriver.FeatureAll})
+	replica := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{
+			{Pattern: regexp.MustCompile("broken"), Response: fakedriver.Response{Err: driver.ErrBadConn}},
+		},
+	})
+
+	l := &countingLogger{events: make(map[string]int)}
+	connector := NewReadWriteConnector(primary, []driver.Connector{replica}, ReadWriteConfig{}, Config{LogHandler: l})
+
+	ctx := WithReplicaRead(context.Background())
+	conn, err := connector.Connect(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stmt, err := conn.(driver.ConnPrepareContext).PrepareContext(ctx, "SELECT 1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err = conn.(driver.QueryerContext).QueryContext(ctx, "SELECT broken", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if events := l.take(); events["ConnClose"] != 0 {
+		t.Errorf("expected dropped replica not to be closed while statement is in use, got %d closes",
+			events["ConnClose"])
+	}
+
+	if err = stmt.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if events := l.take(); events["ConnClose"] != 1 {
+		t.Errorf("expected dropped replica to be closed with its last statement, got %d closes", events["ConnClose"])
+	}
+
+	if err = conn.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if events := l.take(); events["ConnClose"] != 1 {
+		t.Errorf("expected only primary to be closed with connection, got %d closes", events["ConnClose"])
+	}
+}
+
+func TestReadWriteConnectionDropReplicaRows(t *testing.T) {
+	primary := fakedriver.New(fakedriver.Config{Features: fakedriver.FeatureAll})
+	replica := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{
+			{Pattern: regexp.MustCompile("broken"), Response: fakedriver.Response{Err: driver.ErrBadConn}},
+		},
+	})
+
+	l := &countingLogger{events: make(map[string]int)}
+	// Dropped replica is selected again by the next read
+	connector := NewReadWriteConnector(primary, []driver.Connector{replica},
+		ReadWriteConfig{UnhealthyTimeout: time.Nanosecond}, Config{LogHandler: l})
+
+	ctx := WithReplicaRead(context.Background())
+	conn, err := connector.Connect(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer conn.Close()
+
+	for range 3 {
+		rows, err := conn.(driver.QueryerContext).QueryContext(ctx, "SELECT 1", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err = conn.(driver.QueryerContext).QueryContext(ctx, "SELECT broken", nil); err == nil {
+			t.Fatal("expected error")
+		}
+		if events := l.take(); events["ConnClose"] != 0 {
+			t.Errorf("expected dropped replica not to be closed while rows are open, got %d closes",
+				events["ConnClose"])
+		}
+
+		if err = rows.Close(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if events := l.take(); events["ConnClose"] != 1 {
+			t.Errorf("expected dropped replica to be closed with its rows, got %d closes", events["ConnClose"])
+		}
+	}
+
+	if _, err = conn.(driver.QueryerContext).QueryContext(ctx, "SELECT broken", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if events := l.take(); events["ConnClose"] != 1 {
+		t.Errorf("expected unused dropped replica to be closed at once, got %d closes", events["ConnClose"])
+	}
+}
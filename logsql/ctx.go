@@ -0,0 +1,17 @@
+package logsql
+
+const (
+	ctxKeyLimitClass ctxKey = iota
+	ctxKeyLimitNoWait
+	ctxKeySessionSettings
+	ctxKeyReplicaRead
+	ctxKeyTarget
+	ctxKeyResultCache
+	ctxKeyBudget
+	ctxKeyTxID
+)
+
+type (
+	// ctxKey is a type of context keys of the package
+	ctxKey uint8
+)
@@ -0,0 +1,46 @@
+package logsql
+
+type (
+	// Dialect contains SQL syntax that differs between databases. Every field is a format string for [fmt.Sprintf]
+	// with a single %s verb. Empty field means that statement is not supported by the database
+	Dialect struct {
+		Savepoint           string
+		ReleaseSavepoint    string
+		RollbackToSavepoint string
+		// Explain returns plan of the query as rows, see ExplainConfig
+		Explain string
+	}
+)
+
+var (
+	DialectPostgres = Dialect{
+		Savepoint:           "SAVEPOINT %s",
+		ReleaseSavepoint:    "RELEASE SAVEPOINT %s",
+		RollbackToSavepoint: "ROLLBACK TO SAVEPOINT %s",
+		Explain:             "EXPLAIN (FORMAT JSON) %s",
+	}
+
+	DialectMySQL = Dialect{
+		Savepoint:           "SAVEPOINT %s",
+		ReleaseSavepoint:    "RELEASE SAVEPOINT %s",
+		RollbackToSavepoint: "ROLLBACK TO SAVEPOINT %s",
+		Explain:             "EXPLAIN FORMAT=JSON %s",
+	}
+
+	DialectSQLite = Dialect{
+		Savepoint:           "SAVEPOINT %s",
+		ReleaseSavepoint:    "RELEASE SAVEPOINT %s",
+		RollbackToSavepoint: "ROLLBACK TO SAVEPOINT %s",
+		Explain:             "EXPLAIN QUERY PLAN %s",
+	}
+
+	DialectSQLServer = Dialect{
+		Savepoint:           "SAVE TRANSACTION %s",
+		RollbackToSavepoint: "ROLLBACK TRANSACTION %s",
+	}
+
+	DialectOracle = Dialect{
+		Savepoint:           "SAVEPOINT %s",
+		RollbackToSavepoint: "ROLLBACK TO SAVEPOINT %s",
+	}
+)
@@ -1,3 +1,10 @@
 // Package logsql provides wrapper for [database/sql] with Logger interface. To create new logged *sql.DB use either
 // NewConnectorFromDriver or NewConnectorFromConnector to retrieve driver.Connector and pass it to sql.OpenDB.
+//
+// To route reads to replicas use NewReadWriteConnector, to connect to the first available of several hosts use
+// NewFailoverConnector.
+//
+// To inspect and cancel in-flight operations during incidents set Config.Registry and serve Registry.Handler.
+//
+// For resilience testing wrap underlying connector with NewChaosConnector to inject faults.
 package logsql
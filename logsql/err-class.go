@@ -0,0 +1,94 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+)
+
+const (
+	// ErrClassNone is a class of nil error and [io.EOF] returned by RowsNext and RowsNextResultSet
+	ErrClassNone ErrClass = iota
+	// ErrClassCanceled means that context of the operation was canceled
+	ErrClassCanceled
+	// ErrClassDeadlineExceeded means that deadline of context of the operation was exceeded
+	ErrClassDeadlineExceeded
+	// ErrClassTimeout means that default timeout from Config.Timeouts was exceeded, see TimeoutError
+	ErrClassTimeout
+	// ErrClassBadConn means that connection is broken ([driver.ErrBadConn]), [database/sql] retries such operations
+	// on another connection
+	ErrClassBadConn
+	// ErrClassSkip means that driver does not support fast path ([driver.ErrSkip]) and [database/sql] falls back to
+	// another way, e.g. prepared statement
+	ErrClassSkip
+	// ErrClassCircuitOpen means that operation was rejected by open CircuitBreaker
+	ErrClassCircuitOpen
+	// ErrClassOther is a class of any other error, usually returned by the database
+	ErrClassOther
+)
+
+type (
+	// ErrClass is a class of error passed to Logger events, see ClassifyErr
+	ErrClass uint8
+)
+
+var errClassNames = [...]string{
+	ErrClassNone:             "none",
+	ErrClassCanceled:         "canceled",
+	ErrClassDeadlineExceeded: "deadline_exceeded",
+	ErrClassTimeout:          "timeout",
+	ErrClassBadConn:          "bad_conn",
+	ErrClassSkip:             "skip",
+	ErrClassCircuitOpen:      "circuit_open",
+	ErrClassOther:            "other",
+}
+
+// ClassifyErr returns class of err passed to Logger event along with ctx. Drivers often return their own errors
+// when context is done, so such errors are classified by ctx: if ctx is done, the class is ErrClassCanceled or
+// ErrClassDeadlineExceeded regardless of err. ctx can be nil
+func ClassifyErr(ctx context.Context, err error) ErrClass {
+	var timeoutErr *TimeoutError
+
+	switch {
+	case err == nil || errors.Is(err, io.EOF):
+		return ErrClassNone
+	case errors.As(err, &timeoutErr):
+		return ErrClassTimeout
+	case errors.Is(err, context.Canceled):
+		return ErrClassCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrClassDeadlineExceeded
+	case errors.Is(err, driver.ErrSkip):
+		return ErrClassSkip
+	case errors.Is(err, driver.ErrBadConn):
+		return ErrClassBadConn
+	case errors.Is(err, ErrCircuitOpen):
+		return ErrClassCircuitOpen
+	}
+
+	if ctx != nil {
+		switch ctx.Err() {
+		case context.Canceled:
+			return ErrClassCanceled
+		case context.DeadlineExceeded:
+			return ErrClassDeadlineExceeded
+		default:
+		}
+	}
+
+	return ErrClassOther
+}
+
+func (c ErrClass) String() string {
+	if int(c) < len(errClassNames) {
+		return errClassNames[c]
+	}
+
+	return "unknown"
+}
+
+// IsContextErr reports whether class means that operation was interrupted by context or default timeout
+func (c ErrClass) IsContextErr() bool {
+	return c == ErrClassCanceled || c == ErrClassDeadlineExceeded || c == ErrClassTimeout
+}
@@ -3,6 +3,17 @@ package logsql
 import "errors"
 
 var (
-	ErrNilLogHandler       = errors.New("log handler is nil")
-	ErrUnsupportedByDriver = errors.New("unsupported by underlying driver")
+	ErrNilLogHandler         = errors.New("log handler is nil")
+	ErrUnsupportedByDriver   = errors.New("unsupported by underlying driver")
+	ErrUnsupportedDBTX       = errors.New("DBTX must be *sql.DB, *sql.Conn or *sql.Tx")
+	ErrSavepointsUnsupported = errors.New("savepoints are unsupported by dialect")
+	ErrCircuitOpen           = errors.New("circuit breaker is open")
+	ErrLimitExceeded         = errors.New("limit exceeded")
+	ErrLimiterWait           = errors.New("limiter wait exceeds context deadline")
+	ErrPolicyViolation       = errors.New("query violates policy")
+	ErrNoHosts               = errors.New("no hosts")
+	ErrCanceledByRegistry    = errors.New("canceled by registry")
+	ErrBudgetExceeded        = errors.New("query budget exceeded")
+	ErrExplainUnsupported    = errors.New("explain is unsupported by dialect")
+	ErrExplainSkipped        = errors.New("explain skipped by rate limit")
 )
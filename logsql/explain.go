@@ -0,0 +1,197 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// ExplainConfig enables capturing of plans of slow SELECT queries. When Query call takes at least Threshold,
+	// query is explained with the same arguments by Dialect.Explain statement on a separate connection of the
+	// connector in background, and the plan is logged via ExplainLogger. At most one query is explained at a time
+	// and not more often than once per Interval, other slow queries are logged without plan. Explain statement is
+	// canceled after Timeout.
+	// Zero Threshold, Interval and Timeout are replaced by 1 second, 1 minute and 10 seconds respectively
+	ExplainConfig struct {
+		Threshold time.Duration
+		Dialect   Dialect
+		Interval  time.Duration
+		Timeout   time.Duration
+	}
+
+	// ExplainLogger can be optionally implemented by Logger to log plans of slow queries. plan contains rows
+	// returned by explain statement separated by new lines with columns separated by tabs. err is non-nil if query
+	// could not be explained, it is ErrExplainSkipped if explain was not attempted due to rate limit
+	ExplainLogger interface {
+		SlowQuery(ctx context.Context, query string, args []driver.NamedValue, dt time.Duration, plan string, err error)
+	}
+
+	explainer struct {
+		cfg        ExplainConfig
+		logHandler Logger
+		connect    func(ctx context.Context) (driver.Conn, error)
+
+		mu      sync.Mutex
+		running bool
+		last    time.Time
+	}
+
+	// stmtRows close statement along with rows
+	stmtRows struct {
+		driver.Rows
+		stmt driver.Stmt
+	}
+)
+
+func (c ExplainConfig) withDefaults() ExplainConfig {
+	if c.Threshold <= 0 {
+		c.Threshold = time.Second
+	}
+	if c.Interval <= 0 {
+		c.Interval = time.Minute
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 10 * time.Second
+	}
+
+	return c
+}
+
+// newExplainer returns explainer that opens unlogged connections by connect, nil if explain is not configured
+func newExplainer(cfg Config, connect func(ctx context.Context) (driver.Conn, error)) *explainer {
+	if cfg.Explain == nil {
+		return nil
+	}
+
+	return &explainer{
+		cfg:        *cfg.Explain,
+		logHandler: cfg.LogHandler,
+		connect:    connect,
+	}
+}
+
+// observe logs slow SELECT query, it is explained in background if rate limit allows it. Nil explainer does nothing
+func (e *explainer) observe(ctx context.Context, query string, args []driver.NamedValue, dt time.Duration) {
+	if e == nil || dt < e.cfg.Threshold || statementKeyword(tokenizeQuery(query)) != "select" {
+		return
+	}
+
+	el, ok := e.logHandler.(ExplainLogger)
+	if !ok {
+		return
+	}
+
+	e.mu.Lock()
+	if e.running || time.Since(e.last) < e.cfg.Interval {
+		e.mu.Unlock()
+		el.SlowQuery(ctx, query, args, dt, "", ErrExplainSkipped)
+		return
+	}
+	e.running, e.last = true, time.Now()
+	e.mu.Unlock()
+
+	// Query context is likely canceled soon, so only its values are kept
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() {
+			e.mu.Lock()
+			e.running = false
+			e.mu.Unlock()
+		}()
+
+		plan, err := e.explain(ctx, query, args)
+		el.SlowQuery(ctx, query, args, dt, plan, err)
+	}()
+}
+
+func (e *explainer) explain(ctx context.Context, query string, args []driver.NamedValue) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.cfg.Timeout)
+	defer cancel()
+
+	conn, err := e.connect(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	rows, err := queryConn(ctx, conn, fmt.Sprintf(e.cfg.Dialect.Explain, query), args)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var plan strings.Builder
+	dest := make([]driver.Value, len(rows.Columns()))
+	for {
+		err = rows.Next(dest)
+		if err == io.EOF {
+			return plan.String(), nil
+		}
+		if err != nil {
+			return plan.String(), err
+		}
+
+		if plan.Len() > 0 {
+			plan.WriteByte('\n')
+		}
+		for i, v := range dest {
+			if i > 0 {
+				plan.WriteByte('\t')
+			}
+			if b, ok := v.([]byte); ok {
+				v = string(b)
+			}
+			_, _ = fmt.Fprint(&plan, v)
+		}
+	}
+}
+
+// queryConn queries unlogged connection using the most suitable interface it implements. Returned rows keep
+// prepared statement open until they are closed
+func queryConn(ctx context.Context, conn driver.Conn, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if connQueryerCtx, ok := conn.(driver.QueryerContext); ok {
+		rows, err := connQueryerCtx.QueryContext(ctx, query, args)
+		if err != driver.ErrSkip {
+			return rows, err
+		}
+	} else if connQueryer, ok := conn.(driver.Queryer); ok {
+		rows, err := connQueryer.Query(query, driverNamedToValues(args))
+		if err != driver.ErrSkip {
+			return rows, err
+		}
+	}
+
+	var stmt driver.Stmt
+	var err error
+	if connPrepareCtx, ok := conn.(driver.ConnPrepareContext); ok {
+		stmt, err = connPrepareCtx.PrepareContext(ctx, query)
+	} else {
+		stmt, err = conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rows driver.Rows
+	if stQueryerCtx, ok := stmt.(driver.StmtQueryContext); ok {
+		rows, err = stQueryerCtx.QueryContext(ctx, args)
+	} else {
+		rows, err = stmt.Query(driverNamedToValues(args))
+	}
+	if err != nil {
+		_ = stmt.Close()
+		return nil, err
+	}
+
+	return &stmtRows{Rows: rows, stmt: stmt}, nil
+}
+
+func (r *stmtRows) Close() error {
+	return errors.Join(r.Rows.Close(), r.stmt.Close())
+}
@@ -0,0 +1,179 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+type (
+	// explainLogger sends slow queries to channel
+	explainLogger struct {
+		*countingLogger
+		slow chan slowQuery
+	}
+
+	slowQuery struct {
+		query string
+		plan  string
+		err   error
+	}
+)
+
+func (l *explainLogger) SlowQuery(_ context.Context, query string, _ []driver.NamedValue, _ time.Duration, plan string,
+	err error) {
+	l.slow <- slowQuery{query: query, plan: plan, err: err}
+}
+
+// openExplainDB opens database which SELECT and UPDATE statements take 20ms and explain statements take
+// explainLatency, explains counts explain statements
+func openExplainDB(t *testing.T, interval, explainLatency time.Duration, explains *atomic.Int32) (*sql.DB,
+	*explainLogger) {
+	t.Helper()
+
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{
+			{
+				Query: "SELECT 1",
+			},
+			{
+				Pattern: regexp.MustCompile(`^EXPLAIN QUERY PLAN SELECT`),
+				Match: func(string, []driver.NamedValue) bool {
+					explains.Add(1)
+					return true
+				},
+				Response: fakedriver.Response{
+					Columns: []string{"id", "detail"},
+					Rows:    [][]driver.Value{{int64(2), []byte("SCAN t")}, {int64(3), "USE INDEX t_a"}},
+					Latency: explainLatency,
+				},
+			},
+			{
+				Pattern:  regexp.MustCompile(`^(SELECT|UPDATE)`),
+				Response: fakedriver.Response{Latency: 20 * time.Millisecond},
+			},
+		},
+	})
+
+	l := &explainLogger{countingLogger: &countingLogger{events: make(map[string]int)}, slow: make(chan slowQuery, 10)}
+	db := sql.OpenDB(NewConnectorFromConnector(d, Config{
+		LogHandler: l,
+		Explain: &ExplainConfig{
+			Threshold: 10 * time.Millisecond,
+			Dialect:   DialectSQLite,
+			Interval:  interval,
+		},
+	}))
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db, l
+}
+
+// queryClose runs query with a single argument and closes its rows
+func queryClose(t *testing.T, db *sql.DB, q string) {
+	t.Helper()
+
+	rows, err := db.Query(q, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = rows.Close()
+}
+
+// receive waits for slow query to be logged
+func receive(t *testing.T, l *explainLogger) slowQuery {
+	t.Helper()
+
+	select {
+	case sq := <-l.slow:
+		return sq
+	case <-time.After(time.Second):
+		t.Fatalf("expected slow query to be logged")
+		return slowQuery{}
+	}
+}
+
+func TestExplainThreshold(t *testing.T) {
+	var explains atomic.Int32
+	db, l := openExplainDB(t, time.Nanosecond, 0, &explains)
+
+	rows, err := db.Query("SELECT 1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = rows.Close()
+	if _, err = db.Exec("UPDATE t SET a = $1", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	queryClose(t, db, "UPDATE t SET a = $1 RETURNING a")
+	queryClose(t, db, "SELECT a FROM t WHERE id = $1")
+
+	sq := receive(t, l)
+	if sq.query != "SELECT a FROM t WHERE id = $1" || sq.err != nil {
+		t.Errorf("expected only slow SELECT to be explained, got %+v", sq)
+	}
+	if expected := "2\tSCAN t\n3\tUSE INDEX t_a"; sq.plan != expected {
+		t.Errorf("expected plan %q, got %q", expected, sq.plan)
+	}
+
+	select {
+	case sq = <-l.slow:
+		t.Errorf("unexpected slow query %+v", sq)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if explains.Load() != 1 {
+		t.Errorf("expected 1 explain, got %d", explains.Load())
+	}
+}
+
+func TestExplainInterval(t *testing.T) {
+	var explains atomic.Int32
+	db, l := openExplainDB(t, time.Hour, 0, &explains)
+
+	queryClose(t, db, "SELECT a FROM t WHERE id = $1")
+	if sq := receive(t, l); sq.plan == "" || sq.err != nil {
+		t.Errorf("expected plan to be captured, got %+v", sq)
+	}
+
+	queryClose(t, db, "SELECT b FROM t WHERE id = $1")
+	if sq := receive(t, l); sq.query != "SELECT b FROM t WHERE id = $1" || sq.plan != "" ||
+		!errors.Is(sq.err, ErrExplainSkipped) {
+		t.Errorf("expected slow query to be logged without plan, got %+v", sq)
+	}
+	if explains.Load() != 1 {
+		t.Errorf("expected 1 explain, got %d", explains.Load())
+	}
+}
+
+func TestExplainInFlight(t *testing.T) {
+	var explains atomic.Int32
+	db, l := openExplainDB(t, time.Nanosecond, 100*time.Millisecond, &explains)
+
+	queryClose(t, db, "SELECT a FROM t WHERE id = $1")
+	queryClose(t, db, "SELECT b FROM t WHERE id = $1")
+
+	if sq := receive(t, l); sq.query != "SELECT b FROM t WHERE id = $1" || !errors.Is(sq.err, ErrExplainSkipped) {
+		t.Errorf("expected query to be skipped while another one is explained, got %+v", sq)
+	}
+	if sq := receive(t, l); sq.query != "SELECT a FROM t WHERE id = $1" || sq.plan == "" {
+		t.Errorf("expected plan to be captured, got %+v", sq)
+	}
+
+	// Explain is marked finished right after plan is logged
+	time.Sleep(10 * time.Millisecond)
+	queryClose(t, db, "SELECT c FROM t WHERE id = $1")
+	if sq := receive(t, l); sq.query != "SELECT c FROM t WHERE id = $1" || sq.plan == "" {
+		t.Errorf("expected plan to be captured after previous explain, got %+v", sq)
+	}
+	if explains.Load() != 2 {
+		t.Errorf("expected 2 explains, got %d", explains.Load())
+	}
+}
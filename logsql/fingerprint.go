@@ -0,0 +1,165 @@
+package logsql
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	tokenWord tokenKind = iota
+	tokenIdentifier
+	tokenString
+	tokenNumber
+	tokenPlaceholder
+	tokenPunct
+	tokenOperator
+)
+
+type (
+	tokenKind uint8
+
+	queryToken struct {
+		kind tokenKind
+		text string
+	}
+)
+
+// Fingerprint returns normalized query text that is the same for queries that differ only in literals, placeholders,
+// comments, whitespace and letter case of keywords:
+//   - string and numeric literals and placeholders ($1, ?, :name, @name) are replaced with ?
+//   - lists of such values are collapsed, so IN (1, 2, 3) becomes in (?)
+//   - comments are removed and whitespace is collapsed
+//   - everything except quoted identifiers is lowercased
+//
+// Example:
+//
+//	Fingerprint("SELECT * FROM users WHERE id IN (1, 2, 3) AND name = 'John' -- comment")
+//	// select * from users where id in (?) and name = ?
+func Fingerprint(query string) string {
+	tokens := make([]string, 0, len(query)/4)
+	for _, t := range tokenizeQuery(query) {
+		switch t.kind {
+		case tokenString, tokenNumber, tokenPlaceholder:
+			tokens = append(tokens, "?")
+		case tokenWord:
+			tokens = append(tokens, strings.ToLower(t.text))
+		default:
+			tokens = append(tokens, t.text)
+		}
+	}
+
+	return joinTokens(collapseLists(tokens))
+}
+
+// tokenizeQuery splits query into tokens skipping whitespace and comments
+func tokenizeQuery(query string) []queryToken {
+	rs := []rune(query)
+
+	tokens := make([]queryToken, 0, len(rs)/4)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(rs) && rs[i+1] == '-':
+			for i < len(rs) && rs[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(rs) && rs[i+1] == '*':
+			i += 2
+			for i+1 < len(rs) && !(rs[i] == '*' && rs[i+1] == '/') {
+				i++
+			}
+			i += 2
+		case r == '\'':
+			j := skipQuoted(rs, i, '\'')
+			tokens = append(tokens, queryToken{kind: tokenString, text: string(rs[i:j])})
+			i = j
+		case r == '"' || r == '`':
+			j := skipQuoted(rs, i, r)
+			tokens = append(tokens, queryToken{kind: tokenIdentifier, text: string(rs[i:j])})
+			i = j
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(rs) && unicode.IsDigit(rs[i+1])):
+			j := skipWord(rs, i)
+			tokens = append(tokens, queryToken{kind: tokenNumber, text: string(rs[i:j])})
+			i = j
+		case (r == '$' || r == '@' || r == ':') && i+1 < len(rs) && isWordRune(rs[i+1]):
+			j := skipWord(rs, i+1)
+			tokens = append(tokens, queryToken{kind: tokenPlaceholder, text: string(rs[i:j])})
+			i = j
+		case r == '?':
+			i++
+			tokens = append(tokens, queryToken{kind: tokenPlaceholder, text: "?"})
+		case isWordRune(r):
+			j := skipWord(rs, i)
+			tokens = append(tokens, queryToken{kind: tokenWord, text: string(rs[i:j])})
+			i = j
+		case r == '(' || r == ')' || r == ',' || r == ';':
+			i++
+			tokens = append(tokens, queryToken{kind: tokenPunct, text: string(r)})
+		default:
+			j := i + 1
+			for j < len(rs) && isOperatorRune(rs[j]) {
+				j++
+			}
+			tokens = append(tokens, queryToken{kind: tokenOperator, text: string(rs[i:j])})
+			i = j
+		}
+	}
+
+	return tokens
+}
+
+// collapseLists replaces sequences like "?, ?, ?" with a single "?"
+func collapseLists(tokens []string) []string {
+	result := tokens[:0]
+	for _, t := range tokens {
+		n := len(result)
+		if t == "?" && n >= 2 && result[n-1] == "," && result[n-2] == "?" {
+			result = result[:n-1]
+			continue
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+func joinTokens(tokens []string) string {
+	var b strings.Builder
+	for i, t := range tokens {
+		if i > 0 && t != "," && t != ")" && t != ";" && tokens[i-1] != "(" {
+			b.WriteByte(' ')
+		}
+		b.WriteString(t)
+	}
+	return b.String()
+}
+
+func skipQuoted(rs []rune, i int, quote rune) int {
+	for i++; i < len(rs); i++ {
+		if rs[i] != quote {
+			continue
+		}
+		if i+1 < len(rs) && rs[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return i
+}
+
+func skipWord(rs []rune, i int) int {
+	for i < len(rs) && (isWordRune(rs[i]) || rs[i] == '.') {
+		i++
+	}
+	return i
+}
+
+func isOperatorRune(r rune) bool {
+	return !unicode.IsSpace(r) && !isWordRune(r) && !strings.ContainsRune("()',;\"`?$@", r)
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,79 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+type (
+	// ConnHook is called with underlying connection of the driver, so statements executed by hook are not logged
+	// and are not subject to policy, budget, limiter, retries and breaker of Config, e.g. hook setting up session
+	// is not rejected by policy denying string literals. Connection implements optional interfaces of the driver
+	ConnHook func(ctx context.Context, conn driver.Conn) error
+)
+
+// openConnection wraps conn and calls Config.OnConnect hook. Connection is closed if hook fails
+func openConnection(ctx context.Context, cfg Config, e *explainer, conn driver.Conn) (*connection, error) {
+	c := newConnection(cfg, conn)
+	c.explainer = e
+	if cfg.OnConnect == nil {
+		return c, nil
+	}
+
+	if err := cfg.OnConnect(ctx, conn); err != nil {
+		_ = c.Close()
+		return nil, fmt.Errorf("on connect hook: %w", err)
+	}
+
+	return c, nil
+}
+
+// ExecHook returns ConnHook that executes queries without arguments one by one, e.g. "SET TIME ZONE 'UTC'". Queries
+// are prepared if driver does not support direct execution
+func ExecHook(queries ...string) ConnHook {
+	return func(ctx context.Context, conn driver.Conn) error {
+		for _, query := range queries {
+			if err := execConn(ctx, conn, query, nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// execConn executes query on conn, query is prepared if driver does not support direct execution
+func execConn(ctx context.Context, conn driver.Conn, query string, args []driver.NamedValue) error {
+	if connExecerCtx, ok := conn.(driver.ExecerContext); ok {
+		_, err := connExecerCtx.ExecContext(ctx, query, args)
+		if !errors.Is(err, driver.ErrSkip) {
+			return err
+		}
+	} else if connExecer, ok := conn.(driver.Execer); ok {
+		_, err := connExecer.Exec(query, driverNamedToValues(args))
+		if !errors.Is(err, driver.ErrSkip) {
+			return err
+		}
+	}
+
+	var stmt driver.Stmt
+	var err error
+	if connPrepareCtx, ok := conn.(driver.ConnPrepareContext); ok {
+		stmt, err = connPrepareCtx.PrepareContext(ctx, query)
+	} else {
+		stmt, err = conn.Prepare(query)
+	}
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	if stExecerCtx, ok := stmt.(driver.StmtExecContext); ok {
+		_, err = stExecerCtx.ExecContext(ctx, args)
+	} else {
+		_, err = stmt.Exec(driverNamedToValues(args))
+	}
+	return err
+}
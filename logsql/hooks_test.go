@@ -0,0 +1,55 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+func TestHooksBypassConfig(t *testing.T) {
+	var hookQueries atomic.Int32
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{{
+			Query: "SET TIME ZONE 'UTC'",
+			Match: func(string, []driver.NamedValue) bool {
+				hookQueries.Add(1)
+				return true
+			},
+		}},
+	})
+
+	l := &countingLogger{events: make(map[string]int)}
+	db := sql.OpenDB(NewConnectorFromConnector(d, Config{
+		LogHandler: l,
+		Policy:     &QueryPolicy{DenyStringLiterals: true},
+		Budget:     BudgetConfig{Enforce: true},
+		OnConnect:  ExecHook("SET TIME ZONE 'UTC'"),
+		OnReset:    ExecHook("SET TIME ZONE 'UTC'"),
+	}))
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	ctx := WithBudget(context.Background(), 1, 0)
+	for range 2 {
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err = conn.ExecContext(context.Background(), "UPDATE t SET a = $1", 1); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_ = conn.Close()
+	}
+
+	if hookQueries.Load() != 2 {
+		t.Errorf("expected hook to run on connect and on reset, got %d runs", hookQueries.Load())
+	}
+	if events := l.take(); events["Exec"] != 2 {
+		t.Errorf("expected hook statements not to be logged, got %d Exec events", events["Exec"])
+	}
+}
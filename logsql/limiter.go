@@ -0,0 +1,218 @@
+package logsql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+)
+
+type (
+	// LimitClass caps number of concurrent in-flight queries of the class. Query belongs to the class if its context
+	// is tagged with Name via WithLimitClass or, if context is not tagged, if query matches Pattern
+	LimitClass struct {
+		Name          string
+		Pattern       *regexp.Regexp
+		MaxConcurrent int
+	}
+
+	// LimiterConfig configures Limiter. If QPS is positive, all queries are rate-limited to QPS queries per second
+	// with bursts of at most Burst queries, zero Burst is replaced by 1.
+	// If FailFast is true, queries exceeding limits fail immediately with *LimitError instead of waiting,
+	// WithLimitNoWait enables the same behaviour for a single context
+	LimiterConfig struct {
+		Classes  []LimitClass
+		QPS      float64
+		Burst    int
+		FailFast bool
+	}
+
+	// Limiter limits concurrency and rate of Exec and Query calls. Set it to Config.Limiter to apply it to connector.
+	// Query holds its concurrency slot until its rows are closed. Waiting respects context cancellation and deadline.
+	// The same limiter can be shared by several connectors. It is safe for concurrent use
+	Limiter struct {
+		cfg   LimiterConfig
+		slots map[string]chan struct{}
+
+		mu       sync.Mutex
+		tokens   float64
+		lastFill time.Time
+	}
+
+	// LimitError is returned by calls rejected by Limiter. It matches ErrLimitExceeded via [errors.Is]. Err is an
+	// error of context if context was done while call was waiting for Limiter, ErrLimiterWait if rate limit token
+	// would not be available before context deadline, nil if call failed fast
+	LimitError struct {
+		// Class is a name of LimitClass, empty if call was rejected by rate limit
+		Class string
+		Err   error
+	}
+
+	// LimiterLogger can be optionally implemented by Logger to log calls that waited for Limiter or were rejected by
+	// it. class is empty for rate limit
+	LimiterLogger interface {
+		LimiterWait(ctx context.Context, query string, class string, wait time.Duration, err error)
+	}
+)
+
+// NewLimiter returns new Limiter. Classes with non-positive MaxConcurrent are ignored
+func NewLimiter(cfg LimiterConfig) *Limiter {
+	if cfg.Burst <= 0 {
+		cfg.Burst = 1
+	}
+
+	slots := make(map[string]chan struct{}, len(cfg.Classes))
+	for _, class := range cfg.Classes {
+		if class.MaxConcurrent > 0 {
+			slots[class.Name] = make(chan struct{}, class.MaxConcurrent)
+		}
+	}
+
+	return &Limiter{
+		cfg:      cfg,
+		slots:    slots,
+		tokens:   float64(cfg.Burst),
+		lastFill: time.Now(),
+	}
+}
+
+// WithLimitClass tags ctx, so queries with it belong to LimitClass with given name regardless of patterns
+func WithLimitClass(ctx context.Context, class string) context.Context {
+	return context.WithValue(ctx, ctxKeyLimitClass, class)
+}
+
+// WithLimitNoWait makes queries with ctx fail fast with *LimitError instead of waiting for Limiter
+func WithLimitNoWait(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKeyLimitNoWait, true)
+}
+
+func (e *LimitError) Error() string {
+	msg := "rate limit"
+	if e.Class != "" {
+		msg = fmt.Sprintf("class %q", e.Class)
+	}
+
+	if e.Err == nil {
+		return fmt.Sprintf("%s: %s", ErrLimitExceeded, msg)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", ErrLimitExceeded, msg, e.Err)
+}
+
+func (e *LimitError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{ErrLimitExceeded}
+	}
+
+	return []error{ErrLimitExceeded, e.Err}
+}
+
+// acquire waits for concurrency slot of query class and rate limit token. Returned release function frees the slot,
+// it must be called exactly once even if error is returned. Nil Limiter does not limit anything
+func (l *Limiter) acquire(ctx context.Context, log Logger, query string) (release func(), err error) {
+	release = func() {}
+	if l == nil {
+		return release, nil
+	}
+
+	t0 := time.Now()
+	noWait := l.cfg.FailFast || ctx.Value(ctxKeyLimitNoWait) != nil
+
+	class, slot := l.class(ctx, query)
+	if slot != nil {
+		var ok bool
+		release, ok, err = acquireSlot(ctx, slot, noWait)
+		if !ok {
+			err = &LimitError{Class: class, Err: err}
+		}
+	}
+
+	if err == nil && l.cfg.QPS > 0 {
+		if ok, waitErr := l.waitToken(ctx, noWait); !ok {
+			release()
+			release = func() {}
+			class, err = "", &LimitError{Err: waitErr}
+		}
+	}
+
+	if wait := time.Since(t0); err != nil || wait >= time.Millisecond {
+		if ll, ok := log.(LimiterLogger); ok {
+			ll.LimiterWait(ctx, query, class, wait, err)
+		}
+	}
+
+	return release, err
+}
+
+// class returns name and semaphore of query class, nil semaphore if query is not limited
+func (l *Limiter) class(ctx context.Context, query string) (string, chan struct{}) {
+	if name, ok := ctx.Value(ctxKeyLimitClass).(string); ok {
+		return name, l.slots[name]
+	}
+
+	for _, class := range l.cfg.Classes {
+		if class.Pattern != nil && class.Pattern.MatchString(query) {
+			return class.Name, l.slots[class.Name]
+		}
+	}
+
+	return "", nil
+}
+
+// acquireSlot takes slot of semaphore, ok is false if slot was not taken
+func acquireSlot(ctx context.Context, slot chan struct{}, noWait bool) (release func(), ok bool, err error) {
+	release = func() { <-slot }
+
+	select {
+	case slot <- struct{}{}:
+		return release, true, nil
+	default:
+		if noWait {
+			return func() {}, false, nil
+		}
+	}
+
+	select {
+	case slot <- struct{}{}:
+		return release, true, nil
+	case <-ctx.Done():
+		return func() {}, false, ctx.Err()
+	}
+}
+
+// waitToken takes rate limit token, waiting for it if bucket is empty. ok is false if token was not taken
+func (l *Limiter) waitToken(ctx context.Context, noWait bool) (ok bool, err error) {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(float64(l.cfg.Burst), l.tokens+now.Sub(l.lastFill).Seconds()*l.cfg.QPS)
+	l.lastFill = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		l.mu.Unlock()
+		return true, nil
+	}
+
+	wait := time.Duration((1 - l.tokens) / l.cfg.QPS * float64(time.Second))
+	if deadline, ok := ctx.Deadline(); noWait || ok && time.Until(deadline) < wait {
+		l.mu.Unlock()
+		if noWait {
+			return false, nil
+		}
+		return false, ErrLimiterWait
+	}
+
+	// Token is reserved in advance, so concurrent callers wait for subsequent tokens
+	l.tokens--
+	l.mu.Unlock()
+
+	if !sleep(ctx, wait) {
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return false, ctx.Err()
+	}
+
+	return true, nil
+}
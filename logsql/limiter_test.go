@@ -0,0 +1,39 @@
+package logsql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLimiterWaitErrors(t *testing.T) {
+	l := NewLimiter(LimiterConfig{QPS: 1})
+
+	release, err := l.acquire(context.Background(), nil, "SELECT 1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	release, err = l.acquire(ctx, nil, "SELECT 1")
+	release()
+	if !errors.Is(err, ErrLimitExceeded) || !errors.Is(err, ErrLimiterWait) {
+		t.Errorf("expected ErrLimiterWait, got %v", err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected no context error while context is not done, got %v", err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	release, err = l.acquire(ctx, nil, "SELECT 1")
+	release()
+	if !errors.Is(err, ErrLimitExceeded) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
@@ -7,7 +7,11 @@ import (
 )
 
 type (
-	// Logger is used to log specific cases internally in [sql.DB]
+	// Logger is used to log specific cases internally in [sql.DB]. Additional events are logged if Logger also
+	// implements optional interfaces: SessionLogger, ResultSetLogger, RetryLogger, TxRunnerLogger, BreakerLogger,
+	// TimeoutLogger, LimiterLogger, PolicyLogger, ReplicaLogger, FailoverLogger, StmtCacheLogger,
+	// ResultCacheLogger, BudgetLogger, ExplainLogger, ExecResultLogger. Errors passed to events can be classified
+	// with ClassifyErr along with ctx of the event. Events of transactions can be correlated by TxIDFromContext
 	Logger interface {
 		Connect(ctx context.Context, err error, dt time.Duration)
 		ConnClose(ctx context.Context, err error, dt time.Duration)
@@ -30,4 +34,21 @@ type (
 		ExecPreparedStatement(ctx context.Context, query string, args []driver.NamedValue, replacedErr error, err error, dt time.Duration)
 		QueryPreparedStatement(ctx context.Context, query string, args []driver.NamedValue, replacedErr error, err error, dt time.Duration)
 	}
+
+	// SessionLogger can be optionally implemented by Logger to log connection pool related events
+	SessionLogger interface {
+		// ResetSession is called after underlying [driver.SessionResetter] reset the session. Non-nil err usually
+		// means that connection will be discarded by the pool
+		ResetSession(ctx context.Context, err error, dt time.Duration)
+		// ConnInvalidated is called when underlying [driver.Validator] reports that connection is not valid anymore
+		ConnInvalidated(ctx context.Context)
+		// CheckNamedValue is called after underlying [driver.NamedValueChecker] checked value. err can be [driver.ErrSkip]
+		CheckNamedValue(ctx context.Context, value driver.NamedValue, err error)
+	}
+
+	// ResultSetLogger can be optionally implemented by Logger to log transitions between multiple result sets
+	ResultSetLogger interface {
+		// RowsNextResultSet can receive [io.EOF] as err if there are no more result sets
+		RowsNextResultSet(ctx context.Context, err error, dt time.Duration)
+	}
 )
@@ -0,0 +1,132 @@
+package logsqltest
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"regexp"
+)
+
+type (
+	// TB is a subset of [testing.TB] used by assertion helpers
+	TB interface {
+		Helper()
+		Errorf(format string, args ...any)
+	}
+)
+
+// ExpectQueries reports error if number of captured queries is not n. Returns true if assertion succeeded
+func (r *Recorder) ExpectQueries(t TB, n int) bool {
+	t.Helper()
+
+	queries := r.Queries()
+	if len(queries) != n {
+		t.Errorf("expected %d queries, got %d: %v", n, len(queries), queryTexts(queries))
+		return false
+	}
+
+	return true
+}
+
+// ExpectQuery reports error if none of captured queries matches regexp pattern with exactly given args. Args are
+// converted by [driver.DefaultParameterConverter] before comparison, so ExpectQuery(t, `id = \$1`, 1) matches int64(1)
+// passed to the driver. Returns true if assertion succeeded
+func (r *Recorder) ExpectQuery(t TB, pattern string, args ...any) bool {
+	t.Helper()
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Errorf("invalid query pattern %q: %v", pattern, err)
+		return false
+	}
+
+	expected := make([]driver.Value, len(args))
+	for i, arg := range args {
+		expected[i], err = driver.DefaultParameterConverter.ConvertValue(arg)
+		if err != nil {
+			t.Errorf("unsupported #%d argument %v: %v", i, arg, err)
+			return false
+		}
+	}
+
+	queries := r.Queries()
+	for _, q := range queries {
+		if re.MatchString(q.Query) && argsEqual(q.Args, expected) {
+			return true
+		}
+	}
+
+	t.Errorf("expected query matching %q with args %v, got: %v", pattern, expected, queryTexts(queries))
+	return false
+}
+
+// NoQueries reports error if fn has executed any query. Queries executed concurrently by other goroutines are also
+// taken into account. Returns true if assertion succeeded
+func (r *Recorder) NoQueries(t TB, fn func()) bool {
+	t.Helper()
+
+	n := r.len()
+	fn()
+
+	queries := filterQueries(r.since(n))
+	if len(queries) != 0 {
+		t.Errorf("expected no queries, got %d: %v", len(queries), queryTexts(queries))
+		return false
+	}
+
+	return true
+}
+
+// ExpectCommitted reports error if any successfully started transaction was rolled back, failed to commit or
+// is still in progress. Returns true if assertion succeeded
+func (r *Recorder) ExpectCommitted(t TB) bool {
+	t.Helper()
+
+	var begun, committed, failed, rolledBack int
+	for _, e := range r.Events() {
+		switch e.Kind {
+		case EventTxBegin:
+			if e.Err == nil {
+				begun++
+			}
+		case EventTxCommit:
+			if e.Err == nil {
+				committed++
+			} else {
+				failed++
+			}
+		case EventTxRollback:
+			rolledBack++
+		default:
+		}
+	}
+
+	if committed != begun {
+		t.Errorf("expected all %d transactions to be committed, got %d committed, %d failed to commit, %d rolled back",
+			begun, committed, failed, rolledBack)
+		return false
+	}
+
+	return true
+}
+
+func argsEqual(args []driver.NamedValue, expected []driver.Value) bool {
+	if len(args) != len(expected) {
+		return false
+	}
+
+	for i := range args {
+		if !reflect.DeepEqual(args[i].Value, expected[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func queryTexts(queries []Event) []string {
+	texts := make([]string, len(queries))
+	for i, q := range queries {
+		texts[i] = q.Query
+	}
+	return texts
+}
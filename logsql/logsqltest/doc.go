@@ -0,0 +1,13 @@
+// Package logsqltest provides utilities for testing code that uses [logsql]. Recorder is a [logsql.Logger] that
+// captures every event in order and provides assertion helpers. Example:
+//
+//	rec := logsqltest.NewRecorder()
+//	db := sql.OpenDB(logsql.NewConnectorFromConnector(connector, logsql.Config{
+//		LogHandler: rec,
+//	}))
+//
+//	_, _ = db.ExecContext(ctx, `UPDATE users SET name = $1 WHERE id = $2`, "John", 1)
+//
+//	rec.ExpectQueries(t, 1)
+//	rec.ExpectQuery(t, `^UPDATE users`, "John", 1)
+package logsqltest
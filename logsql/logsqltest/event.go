@@ -0,0 +1,93 @@
+package logsqltest
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+const (
+	EventConnect EventKind = iota
+	EventConnClose
+	EventTxBegin
+	EventTxCommit
+	EventTxRollback
+	EventExec
+	EventQuery
+	EventPing
+	EventRowsClose
+	EventRowsNext
+	EventPrepareStatement
+	EventClosePreparedStatement
+	EventExecPreparedStatement
+	EventQueryPreparedStatement
+	EventResetSession
+	EventConnInvalidated
+	EventCheckNamedValue
+	EventRowsNextResultSet
+)
+
+type (
+	// EventKind is a kind of logged event, every kind corresponds to a method of [logsql.Logger] or one of its
+	// optional interfaces
+	EventKind uint8
+
+	// Event is a single call of Logger method. Fields that are not provided by the method are left empty. ErrClass
+	// is a class of Err computed by [logsql.ClassifyErr] when the event is captured
+	Event struct {
+		Kind EventKind
+
+		Ctx         context.Context
+		Query       string
+		Args        []driver.NamedValue
+		Dest        []driver.Value
+		ReplacedErr error
+		Err         error
+		ErrClass    logsql.ErrClass
+		Duration    time.Duration
+	}
+)
+
+var eventKindNames = [...]string{
+	EventConnect:                "Connect",
+	EventConnClose:              "ConnClose",
+	EventTxBegin:                "TxBegin",
+	EventTxCommit:               "TxCommit",
+	EventTxRollback:             "TxRollback",
+	EventExec:                   "Exec",
+	EventQuery:                  "Query",
+	EventPing:                   "Ping",
+	EventRowsClose:              "RowsClose",
+	EventRowsNext:               "RowsNext",
+	EventPrepareStatement:       "PrepareStatement",
+	EventClosePreparedStatement: "ClosePreparedStatement",
+	EventExecPreparedStatement:  "ExecPreparedStatement",
+	EventQueryPreparedStatement: "QueryPreparedStatement",
+	EventResetSession:           "ResetSession",
+	EventConnInvalidated:        "ConnInvalidated",
+	EventCheckNamedValue:        "CheckNamedValue",
+	EventRowsNextResultSet:      "RowsNextResultSet",
+}
+
+func (k EventKind) String() string {
+	if int(k) < len(eventKindNames) {
+		return eventKindNames[k]
+	}
+
+	return "Unknown"
+}
+
+// IsQuery reports whether event is an execution of a query: Exec, Query, ExecPreparedStatement or
+// QueryPreparedStatement. Events with [driver.ErrSkip] are not executions, since [database/sql] falls back to
+// another path and executes the query again
+func (e Event) IsQuery() bool {
+	switch e.Kind {
+	case EventExec, EventQuery, EventExecPreparedStatement, EventQueryPreparedStatement:
+		return !errors.Is(e.Err, driver.ErrSkip)
+	default:
+		return false
+	}
+}
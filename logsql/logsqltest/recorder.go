@@ -0,0 +1,151 @@
+package logsqltest
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync"
+	"time"
+
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+var (
+	_ logsql.Logger          = (*Recorder)(nil)
+	_ logsql.SessionLogger   = (*Recorder)(nil)
+	_ logsql.ResultSetLogger = (*Recorder)(nil)
+)
+
+type (
+	// Recorder is a [logsql.Logger] that captures every event in order. It is safe for concurrent use
+	Recorder struct {
+		mu     sync.Mutex
+		events []Event
+	}
+)
+
+// NewRecorder returns new empty Recorder
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Events returns copy of all captured events in order
+func (r *Recorder) Events() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := make([]Event, len(r.events))
+	copy(events, r.events)
+
+	return events
+}
+
+// Queries returns captured events for which Event.IsQuery is true
+func (r *Recorder) Queries() []Event {
+	return filterQueries(r.Events())
+}
+
+// Reset drops all captured events
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = nil
+}
+
+func (r *Recorder) record(e Event) {
+	e.ErrClass = logsql.ClassifyErr(e.Ctx, e.Err)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, e)
+}
+
+func (r *Recorder) len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.events)
+}
+
+func (r *Recorder) since(n int) []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := make([]Event, len(r.events)-n)
+	copy(events, r.events[n:])
+
+	return events
+}
+
+func (r *Recorder) Connect(ctx context.Context, err error, dt time.Duration) {
+	r.record(Event{Kind: EventConnect, Ctx: ctx, Err: err, Duration: dt})
+}
+
+func (r *Recorder) ConnClose(ctx context.Context, err error, dt time.Duration) {
+	r.record(Event{Kind: EventConnClose, Ctx: ctx, Err: err, Duration: dt})
+}
+
+func (r *Recorder) TxBegin(ctx context.Context, err error, dt time.Duration) {
+	r.record(Event{Kind: EventTxBegin, Ctx: ctx, Err: err, Duration: dt})
+}
+
+func (r *Recorder) TxCommit(ctx context.Context, err error, dt time.Duration) {
+	r.record(Event{Kind: EventTxCommit, Ctx: ctx, Err: err, Duration: dt})
+}
+
+func (r *Recorder) TxRollback(ctx context.Context, err error, dt time.Duration) {
+	r.record(Event{Kind: EventTxRollback, Ctx: ctx, Err: err, Duration: dt})
+}
+
+func (r *Recorder) Exec(ctx context.Context, query string, args []driver.NamedValue, replacedErr error, err error, dt time.Duration) {
+	r.record(Event{Kind: EventExec, Ctx: ctx, Query: query, Args: copyArgs(args), ReplacedErr: replacedErr, Err: err, Duration: dt})
+}
+
+func (r *Recorder) Query(ctx context.Context, query string, args []driver.NamedValue, replacedErr error, err error, dt time.Duration) {
+	r.record(Event{Kind: EventQuery, Ctx: ctx, Query: query, Args: copyArgs(args), ReplacedErr: replacedErr, Err: err, Duration: dt})
+}
+
+func (r *Recorder) Ping(ctx context.Context, err error, dt time.Duration) {
+	r.record(Event{Kind: EventPing, Ctx: ctx, Err: err, Duration: dt})
+}
+
+func (r *Recorder) RowsClose(ctx context.Context, err error, dt time.Duration) {
+	r.record(Event{Kind: EventRowsClose, Ctx: ctx, Err: err, Duration: dt})
+}
+
+func (r *Recorder) RowsNext(ctx context.Context, dest []driver.Value, err error, dt time.Duration) {
+	r.record(Event{Kind: EventRowsNext, Ctx: ctx, Dest: copyValues(dest), Err: err, Duration: dt})
+}
+
+func (r *Recorder) PrepareStatement(ctx context.Context, query string, err error, dt time.Duration) {
+	r.record(Event{Kind: EventPrepareStatement, Ctx: ctx, Query: query, Err: err, Duration: dt})
+}
+
+func (r *Recorder) ClosePreparedStatement(ctx context.Context, query string, err error, dt time.Duration) {
+	r.record(Event{Kind: EventClosePreparedStatement, Ctx: ctx, Query: query, Err: err, Duration: dt})
+}
+
+func (r *Recorder) ExecPreparedStatement(ctx context.Context, query string, args []driver.NamedValue, replacedErr error, err error, dt time.Duration) {
+	r.record(Event{Kind: EventExecPreparedStatement, Ctx: ctx, Query: query, Args: copyArgs(args), ReplacedErr: replacedErr, Err: err, Duration: dt})
+}
+
+func (r *Recorder) QueryPreparedStatement(ctx context.Context, query string, args []driver.NamedValue, replacedErr error, err error, dt time.Duration) {
+	r.record(Event{Kind: EventQueryPreparedStatement, Ctx: ctx, Query: query, Args: copyArgs(args), ReplacedErr: replacedErr, Err: err, Duration: dt})
+}
+
+func (r *Recorder) ResetSession(ctx context.Context, err error, dt time.Duration) {
+	r.record(Event{Kind: EventResetSession, Ctx: ctx, Err: err, Duration: dt})
+}
+
+func (r *Recorder) ConnInvalidated(ctx context.Context) {
+	r.record(Event{Kind: EventConnInvalidated, Ctx: ctx})
+}
+
+func (r *Recorder) CheckNamedValue(ctx context.Context, value driver.NamedValue, err error) {
+	r.record(Event{Kind: EventCheckNamedValue, Ctx: ctx, Args: []driver.NamedValue{value}, Err: err})
+}
+
+func (r *Recorder) RowsNextResultSet(ctx context.Context, err error, dt time.Duration) {
+	r.record(Event{Kind: EventRowsNextResultSet, Ctx: ctx, Err: err, Duration: dt})
+}
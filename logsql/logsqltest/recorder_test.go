@@ -0,0 +1,51 @@
+package logsqltest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+// TestRecorderSkipFallback checks that query which fast path returned driver.ErrSkip is counted once
+func TestRecorderSkipFallback(t *testing.T) {
+	rec := NewRecorder()
+	db := sql.OpenDB(logsql.NewConnectorFromConnector(fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{
+			{Query: "UPDATE users SET name = $1", Times: 1, Response: fakedriver.Response{Err: driver.ErrSkip}},
+		},
+	}), logsql.Config{LogHandler: rec}))
+	defer db.Close()
+
+	_, err := db.Exec("UPDATE users SET name = $1", "John")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec.ExpectQueries(t, 1)
+	rec.ExpectQuery(t, `^UPDATE users`, "John")
+}
+
+func TestRecorderErrClass(t *testing.T) {
+	rec := NewRecorder()
+	db := sql.OpenDB(logsql.NewConnectorFromConnector(fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Default:  fakedriver.Response{Latency: time.Hour},
+	}), logsql.Config{LogHandler: rec}))
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _ = db.ExecContext(ctx, "UPDATE users SET name = $1", "John")
+
+	queries := rec.Queries()
+	if len(queries) != 1 || queries[0].ErrClass != logsql.ErrClassDeadlineExceeded {
+		t.Errorf("expected query with %s error class, got %v", logsql.ErrClassDeadlineExceeded, queries)
+	}
+}
@@ -0,0 +1,34 @@
+package logsqltest
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// copyValues copies dest since drivers may reuse it (including []byte values) between calls of Next
+func copyValues(dest []driver.Value) []driver.Value {
+	result := make([]driver.Value, len(dest))
+	for i, v := range dest {
+		if b, ok := v.([]byte); ok {
+			v = bytes.Clone(b)
+		}
+		result[i] = v
+	}
+	return result
+}
+
+func copyArgs(args []driver.NamedValue) []driver.NamedValue {
+	result := make([]driver.NamedValue, len(args))
+	copy(result, args)
+	return result
+}
+
+func filterQueries(events []Event) []Event {
+	var queries []Event
+	for _, e := range events {
+		if e.IsQuery() {
+			queries = append(queries, e)
+		}
+	}
+	return queries
+}
@@ -0,0 +1,36 @@
+package logsql
+
+const (
+	OpConnect Op = iota
+	OpPrepare
+	OpBegin
+	OpCommit
+	OpRollback
+	OpExec
+	OpQuery
+	OpPing
+)
+
+type (
+	// Op is a kind of operation performed by logged connector
+	Op uint8
+)
+
+var opNames = [...]string{
+	OpConnect:  "connect",
+	OpPrepare:  "prepare",
+	OpBegin:    "begin",
+	OpCommit:   "commit",
+	OpRollback: "rollback",
+	OpExec:     "exec",
+	OpQuery:    "query",
+	OpPing:     "ping",
+}
+
+func (o Op) String() string {
+	if int(o) < len(opNames) {
+		return opNames[o]
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,266 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+var (
+	connFeatures = []fakedriver.Feature{
+		fakedriver.FeatureConnBeginTx,
+		fakedriver.FeatureConnPrepareContext,
+		fakedriver.FeatureExecer,
+		fakedriver.FeatureExecerContext,
+		fakedriver.FeatureQueryer,
+		fakedriver.FeatureQueryerContext,
+		fakedriver.FeaturePinger,
+		fakedriver.FeatureSessionResetter,
+		fakedriver.FeatureValidator,
+		fakedriver.FeatureConnNamedValueChecker,
+	}
+	stmtFeatures = []fakedriver.Feature{
+		fakedriver.FeatureStmtExecContext,
+		fakedriver.FeatureStmtQueryContext,
+		fakedriver.FeatureStmtNamedValueChecker,
+		fakedriver.FeatureColumnConverter,
+	}
+	rowsFeatures = []fakedriver.Feature{
+		fakedriver.FeatureRowsNextResultSet,
+		fakedriver.FeatureRowsColumnTypeScanType,
+		fakedriver.FeatureRowsColumnTypeDatabaseTypeName,
+		fakedriver.FeatureRowsColumnTypeLength,
+		fakedriver.FeatureRowsColumnTypeNullable,
+		fakedriver.FeatureRowsColumnTypePrecisionScale,
+	}
+)
+
+type (
+	// countingLogger counts events by name
+	countingLogger struct {
+		mu     sync.Mutex
+		events map[string]int
+	}
+
+	// parityPath is a way to execute a query through logged connection. It returns driver.ErrSkip if and only if the
+	// driver implements none of features, the path is always available if features are empty
+	parityPath struct {
+		name     string
+		event    string
+		features fakedriver.Feature
+		call     func(ctx context.Context, conn driver.Conn) (any, error)
+	}
+)
+
+var parityPaths = []parityPath{
+	{"Execer", "Exec", fakedriver.FeatureExecer,
+		func(_ context.Context, conn driver.Conn) (any, error) {
+			return conn.(driver.Execer).Exec("UPDATE t SET a = 1", nil)
+		}},
+	{"ExecerContext", "Exec", fakedriver.FeatureExecer | fakedriver.FeatureExecerContext,
+		func(ctx context.Context, conn driver.Conn) (any, error) {
+			return conn.(driver.ExecerContext).ExecContext(ctx, "UPDATE t SET a = 1", nil)
+		}},
+	{"Queryer", "Query", fakedriver.FeatureQueryer,
+		func(_ context.Context, conn driver.Conn) (any, error) {
+			return conn.(driver.Queryer).Query("SELECT a FROM t", nil)
+		}},
+	{"QueryerContext", "Query", fakedriver.FeatureQueryer | fakedriver.FeatureQueryerContext,
+		func(ctx context.Context, conn driver.Conn) (any, error) {
+			return conn.(driver.QueryerContext).QueryContext(ctx, "SELECT a FROM t", nil)
+		}},
+	{"Stmt.Exec", "ExecPreparedStatement", fakedriver.FeatureNone,
+		func(_ context.Context, conn driver.Conn) (any, error) {
+			return withStmt(conn, func(stmt driver.Stmt) (any, error) {
+				return stmt.Exec(nil)
+			})
+		}},
+	{"Stmt.ExecContext", "ExecPreparedStatement", fakedriver.FeatureNone,
+		func(ctx context.Context, conn driver.Conn) (any, error) {
+			return withStmt(conn, func(stmt driver.Stmt) (any, error) {
+				return stmt.(driver.StmtExecContext).ExecContext(ctx, nil)
+			})
+		}},
+	{"Stmt.Query", "QueryPreparedStatement", fakedriver.FeatureNone,
+		func(_ context.Context, conn driver.Conn) (any, error) {
+			return withStmt(conn, func(stmt driver.Stmt) (any, error) {
+				return stmt.Query(nil)
+			})
+		}},
+	{"Stmt.QueryContext", "QueryPreparedStatement", fakedriver.FeatureNone,
+		func(ctx context.Context, conn driver.Conn) (any, error) {
+			return withStmt(conn, func(stmt driver.Stmt) (any, error) {
+				return stmt.(driver.StmtQueryContext).QueryContext(ctx, nil)
+			})
+		}},
+}
+
+func (l *countingLogger) add(event string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.events[event]++
+}
+
+func (l *countingLogger) take() map[string]int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	events := l.events
+	l.events = make(map[string]int)
+
+	return events
+}
+
+func (l *countingLogger) Connect(context.Context, error, time.Duration)    { l.add("Connect") }
+func (l *countingLogger) ConnClose(context.Context, error, time.Duration)  { l.add("ConnClose") }
+func (l *countingLogger) TxBegin(context.Context, error, time.Duration)    { l.add("TxBegin") }
+func (l *countingLogger) TxCommit(context.Context, error, time.Duration)   { l.add("TxCommit") }
+func (l *countingLogger) TxRollback(context.Context, error, time.Duration) { l.add("TxRollback") }
+func (l *countingLogger) Ping(context.Context, error, time.Duration)       { l.add("Ping") }
+func (l *countingLogger) RowsClose(context.Context, error, time.Duration)  { l.add("RowsClose") }
+
+func (l *countingLogger) Exec(context.Context, string, []driver.NamedValue, error, error, time.Duration) {
+	l.add("Exec")
+}
+
+func (l *countingLogger) Query(context.Context, string, []driver.NamedValue, error, error, time.Duration) {
+	l.add("Query")
+}
+
+func (l *countingLogger) RowsNext(context.Context, []driver.Value, error, time.Duration) {
+	l.add("RowsNext")
+}
+
+func (l *countingLogger) PrepareStatement(context.Context, string, error, time.Duration) {
+	l.add("PrepareStatement")
+}
+
+func (l *countingLogger) ClosePreparedStatement(context.Context, string, error, time.Duration) {
+	l.add("ClosePreparedStatement")
+}
+
+func (l *countingLogger) ExecPreparedStatement(context.Context, string, []driver.NamedValue, error, error,
+	time.Duration) {
+	l.add("ExecPreparedStatement")
+}
+
+func (l *countingLogger) QueryPreparedStatement(context.Context, string, []driver.NamedValue, error, error,
+	time.Duration) {
+	l.add("QueryPreparedStatement")
+}
+
+// withStmt prepares statement by the most suitable interface and closes it after call
+func withStmt(conn driver.Conn, call func(stmt driver.Stmt) (any, error)) (any, error) {
+	stmt, err := conn.(driver.ConnPrepareContext).PrepareContext(context.Background(), "SELECT a FROM t")
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	return call(stmt)
+}
+
+// combinations returns every combination of features
+func combinations(features []fakedriver.Feature) []fakedriver.Feature {
+	result := []fakedriver.Feature{fakedriver.FeatureNone}
+	for _, f := range features {
+		for _, c := range result {
+			result = append(result, c|f)
+		}
+	}
+
+	return result
+}
+
+// TestParity checks that every path through logged connection returns wrapped rows and results which log their
+// events, for every combination of optional interfaces implemented by the driver
+func TestParity(t *testing.T) {
+	var masks []fakedriver.Feature
+	for _, conn := range combinations(connFeatures) {
+		for _, stmt := range combinations(stmtFeatures) {
+			masks = append(masks, conn|stmt|fakedriver.FeatureRowsAll)
+		}
+	}
+	for _, rows := range combinations(rowsFeatures) {
+		masks = append(masks, fakedriver.FeatureConnAll|fakedriver.FeatureStmtAll|rows)
+	}
+
+	for _, mask := range masks {
+		testParity(t, mask)
+	}
+}
+
+func testParity(t *testing.T, mask fakedriver.Feature) {
+	t.Helper()
+
+	ctx := context.Background()
+	l := &countingLogger{events: make(map[string]int)}
+	connector := NewConnectorFromConnector(fakedriver.New(fakedriver.Config{
+		Features: mask,
+		Default: fakedriver.Response{
+			Columns:      []string{"a"},
+			Rows:         [][]driver.Value{{int64(1)}},
+			RowsAffected: 1,
+		},
+	}), Config{LogHandler: l})
+
+	conn, err := connector.Connect(ctx)
+	if err != nil {
+		t.Fatalf("features %#x: connect: %v", mask, err)
+	}
+	defer conn.Close()
+
+	for _, path := range parityPaths {
+		l.take()
+
+		v, err := path.call(ctx, conn)
+		if path.features != fakedriver.FeatureNone && mask&path.features == 0 {
+			if !errors.Is(err, driver.ErrSkip) {
+				t.Errorf("features %#x: %s: expected driver.ErrSkip, got %v", mask, path.name, err)
+			}
+			if events := l.take(); events[path.event] != 0 {
+				t.Errorf("features %#x: %s: %d %s events", mask, path.name, events[path.event], path.event)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("features %#x: %s: %v", mask, path.name, err)
+			continue
+		}
+
+		switch v := v.(type) {
+		case *queryResult:
+			if n, err := v.RowsAffected(); err != nil || n != 1 {
+				t.Errorf("features %#x: %s: rows affected %d, %v", mask, path.name, n, err)
+			}
+		case *queryRows:
+			dest := make([]driver.Value, 1)
+			if err = v.Next(dest); err != nil {
+				t.Errorf("features %#x: %s: next: %v", mask, path.name, err)
+			}
+			if err = v.Next(dest); err != io.EOF {
+				t.Errorf("features %#x: %s: expected EOF, got %v", mask, path.name, err)
+			}
+			_ = v.Close()
+		default:
+			t.Errorf("features %#x: %s: unwrapped %T", mask, path.name, v)
+			continue
+		}
+
+		events := l.take()
+		if events[path.event] != 1 {
+			t.Errorf("features %#x: %s: %d %s events", mask, path.name, events[path.event], path.event)
+		}
+		if _, ok := v.(*queryRows); ok && (events["RowsNext"] != 2 || events["RowsClose"] != 1) {
+			t.Errorf("features %#x: %s: %d RowsNext and %d RowsClose events", mask, path.name,
+				events["RowsNext"], events["RowsClose"])
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package logsql
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// ViolationDDL means that query contains DDL statement (CREATE, ALTER, DROP, TRUNCATE, RENAME, COMMENT,
+	// GRANT, REVOKE)
+	ViolationDDL PolicyViolation = iota + 1
+	// ViolationUnboundedWrite means that query contains UPDATE or DELETE statement without WHERE clause
+	ViolationUnboundedWrite
+	// ViolationMultiStatement means that query contains several statements separated by semicolon
+	ViolationMultiStatement
+	// ViolationStringLiteral means that query contains inline string literal instead of a placeholder
+	ViolationStringLiteral
+)
+
+var (
+	ddlKeywords = []string{"create", "alter", "drop", "truncate", "rename", "comment", "grant", "revoke"}
+)
+
+type (
+	// PolicyViolation is a kind of query that is denied by QueryPolicy
+	PolicyViolation uint8
+
+	// QueryPolicy rejects queries passed to Prepare, Exec and Query of connections with *PolicyError before they
+	// reach the driver. Set it to Config.Policy to apply it to connector. If ReportOnly is true, violations are only
+	// logged via PolicyLogger and queries are passed to the driver as is.
+	//
+	// Policy is checked by simple tokenizer that is not aware of dialect specific syntax like dollar-quoted strings,
+	// so it should be used as a guardrail, not as a protection against SQL injections
+	QueryPolicy struct {
+		DenyDDL             bool
+		DenyUnboundedWrites bool
+		DenyMultiStatements bool
+		DenyStringLiterals  bool
+		ReportOnly          bool
+	}
+
+	// PolicyError is returned for queries denied by QueryPolicy. It matches ErrPolicyViolation via [errors.Is]
+	PolicyError struct {
+		Violation PolicyViolation
+		Query     string
+	}
+
+	// PolicyLogger can be optionally implemented by Logger to log queries violating QueryPolicy. reportOnly is true
+	// if query was not rejected
+	PolicyLogger interface {
+		PolicyViolation(ctx context.Context, query string, violation PolicyViolation, reportOnly bool)
+	}
+)
+
+func (v PolicyViolation) String() string {
+	switch v {
+	case 0:
+		return "none"
+	case ViolationDDL:
+		return "ddl"
+	case ViolationUnboundedWrite:
+		return "unbounded write"
+	case ViolationMultiStatement:
+		return "multiple statements"
+	case ViolationStringLiteral:
+		return "string literal"
+	default:
+		return "unknown"
+	}
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPolicyViolation, e.Violation)
+}
+
+func (e *PolicyError) Unwrap() error {
+	return ErrPolicyViolation
+}
+
+// Violation returns the first violation of the policy found in query, zero if query is allowed
+func (p *QueryPolicy) Violation(query string) PolicyViolation {
+	tokens := tokenizeQuery(query)
+
+	if p.DenyStringLiterals && slices.ContainsFunc(tokens, func(t queryToken) bool { return t.kind == tokenString }) {
+		return ViolationStringLiteral
+	}
+
+	statements := splitStatements(tokens)
+	if p.DenyMultiStatements && len(statements) > 1 {
+		return ViolationMultiStatement
+	}
+
+	for _, statement := range statements {
+		for _, part := range withCTEs(statement) {
+			keyword := statementKeyword(part)
+			if p.DenyDDL && slices.Contains(ddlKeywords, keyword) {
+				return ViolationDDL
+			}
+			if p.DenyUnboundedWrites && (keyword == "update" || keyword == "delete") &&
+				!hasTopLevelWord(part, "where") {
+				return ViolationUnboundedWrite
+			}
+		}
+	}
+
+	return 0
+}
+
+// StatementKeywords returns lowercased leading keywords of statements of query, e.g. [select] or [insert delete].
+// For statements with common table expressions keyword of the main statement is followed by keywords of the
+// expressions, e.g. [select delete] for WITH d AS (DELETE FROM t RETURNING *) SELECT * FROM d
+func StatementKeywords(query string) []string {
+	statements := splitStatements(tokenizeQuery(query))
+
+	keywords := make([]string, 0, len(statements))
+	for _, statement := range statements {
+		for _, part := range withCTEs(statement) {
+			keywords = append(keywords, statementKeyword(part))
+		}
+	}
+
+	return keywords
+}
+
+// check returns *PolicyError if query violates the policy and logs violation. Nil policy allows everything
+func (p *QueryPolicy) check(ctx context.Context, l Logger, query string) error {
+	if p == nil {
+		return nil
+	}
+
+	violation := p.Violation(query)
+	if violation == 0 {
+		return nil
+	}
+
+	if pl, ok := l.(PolicyLogger); ok {
+		pl.PolicyViolation(ctx, query, violation, p.ReportOnly)
+	}
+
+	if p.ReportOnly {
+		return nil
+	}
+
+	return &PolicyError{
+		Violation: violation,
+		Query:     query,
+	}
+}
+
+// splitStatements splits tokens by semicolons omitting empty statements
+func splitStatements(tokens []queryToken) [][]queryToken {
+	var statements [][]queryToken
+
+	start := 0
+	for i := 0; i <= len(tokens); i++ {
+		if i < len(tokens) && (tokens[i].kind != tokenPunct || tokens[i].text != ";") {
+			continue
+		}
+		if i > start {
+			statements = append(statements, tokens[start:i])
+		}
+		start = i + 1
+	}
+
+	return statements
+}
+
+// statementKeyword returns lowercased leading keyword of statement. For statements with common table expressions
+// keyword of the main statement is returned
+func statementKeyword(statement []queryToken) string {
+	if len(statement) == 0 || statement[0].kind != tokenWord {
+		return ""
+	}
+
+	keyword := strings.ToLower(statement[0].text)
+	if keyword != "with" {
+		return keyword
+	}
+
+	depth := 0
+	for _, t := range statement[1:] {
+		switch {
+		case t.kind == tokenPunct && t.text == "(":
+			depth++
+		case t.kind == tokenPunct && t.text == ")":
+			depth--
+		case depth == 0 && t.kind == tokenWord:
+			switch w := strings.ToLower(t.text); w {
+			case "select", "insert", "update", "delete", "merge":
+				return w
+			}
+		}
+	}
+
+	return keyword
+}
+
+// withCTEs returns statement followed by bodies of its common table expressions, expressions of the bodies are
+// included as well
+func withCTEs(statement []queryToken) [][]queryToken {
+	result := [][]queryToken{statement}
+	if len(statement) == 0 || statement[0].kind != tokenWord || !strings.EqualFold(statement[0].text, "with") {
+		return result
+	}
+
+	// prev is the previous word outside of parentheses, body of expression follows AS or AS [NOT] MATERIALIZED
+	depth, start, prev := 0, 0, ""
+	for i := 1; i < len(statement); i++ {
+		t := statement[i]
+		switch {
+		case t.kind == tokenPunct && t.text == "(":
+			if depth == 0 && (prev == "as" || prev == "materialized") {
+				start = i + 1
+			}
+			depth++
+		case t.kind == tokenPunct && t.text == ")":
+			depth--
+			if depth == 0 && start > 0 {
+				result = append(result, withCTEs(statement[start:i])...)
+				start = 0
+			}
+		case depth == 0 && t.kind == tokenWord:
+			switch strings.ToLower(t.text) {
+			case "select", "insert", "update", "delete", "merge":
+				return result
+			}
+		}
+
+		prev = ""
+		if depth == 0 && t.kind == tokenWord {
+			prev = strings.ToLower(t.text)
+		}
+	}
+
+	return result
+}
+
+// hasTopLevelWord reports whether statement contains word outside of parentheses
+func hasTopLevelWord(statement []queryToken, word string) bool {
+	depth := 0
+	for _, t := range statement {
+		switch {
+		case t.kind == tokenPunct && t.text == "(":
+			depth++
+		case t.kind == tokenPunct && t.text == ")":
+			depth--
+		case depth == 0 && t.kind == tokenWord && strings.EqualFold(t.text, word):
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+type (
+	// policyLogger records logged violations
+	policyLogger struct {
+		*countingLogger
+		violations []string
+	}
+)
+
+func (l *policyLogger) PolicyViolation(_ context.Context, _ string, violation PolicyViolation, reportOnly bool) {
+	if reportOnly {
+		l.violations = append(l.violations, "report "+violation.String())
+	} else {
+		l.violations = append(l.violations, "reject "+violation.String())
+	}
+}
+
+func TestQueryPolicyViolation(t *testing.T) {
+	p := &QueryPolicy{DenyDDL: true, DenyUnboundedWrites: true, DenyMultiStatements: true, DenyStringLiterals: true}
+
+	tests := []struct {
+		query     string
+		violation PolicyViolation
+	}{
+		{query: "SELECT a FROM t WHERE b = $1"},
+		{query: `SELECT "a" FROM t -- it's fine`},
+		{query: "SELECT a FROM t WHERE b = 'x'", violation: ViolationStringLiteral},
+		{query: "SELECT 1; SELECT 2", violation: ViolationMultiStatement},
+		{query: "DROP TABLE t", violation: ViolationDDL},
+		{query: "UPDATE t SET a = 1 WHERE id = $1"},
+		{query: "UPDATE t SET a = 1", violation: ViolationUnboundedWrite},
+		{query: "DELETE FROM t", violation: ViolationUnboundedWrite},
+		{query: "delete from t where id = $1"},
+		{query: "UPDATE t SET a = (SELECT b FROM u WHERE u.id = t.id)", violation: ViolationUnboundedWrite},
+		{query: "WITH d AS (DELETE FROM t RETURNING *) SELECT * FROM d", violation: ViolationUnboundedWrite},
+		{query: "WITH d AS (DELETE FROM t WHERE id = $1 RETURNING *) SELECT * FROM d"},
+		{
+			query:     "WITH s(id) AS (SELECT $1), u AS MATERIALIZED (UPDATE t SET a = 1 RETURNING id) SELECT * FROM u",
+			violation: ViolationUnboundedWrite,
+		},
+		{
+			query:     "WITH a AS (WITH d AS (DELETE FROM t RETURNING *) SELECT * FROM d) SELECT * FROM a",
+			violation: ViolationUnboundedWrite,
+		},
+		{query: "WITH d AS (SELECT a FROM t) DELETE FROM u", violation: ViolationUnboundedWrite},
+	}
+
+	for _, tt := range tests {
+		if violation := p.Violation(tt.query); violation != tt.violation {
+			t.Errorf("%s: expected %s, got %s", tt.query, tt.violation, violation)
+		}
+	}
+}
+
+func TestStatementKeywords(t *testing.T) {
+	tests := []struct {
+		query    string
+		keywords []string
+	}{
+		{query: "SELECT 1", keywords: []string{"select"}},
+		{query: "INSERT INTO t VALUES (1); DELETE FROM t", keywords: []string{"insert", "delete"}},
+		{query: "WITH d AS (DELETE FROM t RETURNING *) SELECT * FROM d", keywords: []string{"select", "delete"}},
+		{query: "WITH s AS (SELECT 1) UPDATE t SET a = 1", keywords: []string{"update", "select"}},
+	}
+
+	for _, tt := range tests {
+		if keywords := StatementKeywords(tt.query); !slices.Equal(keywords, tt.keywords) {
+			t.Errorf("%s: expected %q, got %q", tt.query, tt.keywords, keywords)
+		}
+	}
+}
+
+func TestQueryPolicyReportOnly(t *testing.T) {
+	for _, reportOnly := range []bool{false, true} {
+		d := fakedriver.New(fakedriver.Config{Features: fakedriver.FeatureAll})
+		l := &policyLogger{countingLogger: &countingLogger{events: make(map[string]int)}}
+		db := sql.OpenDB(NewConnectorFromConnector(d, Config{
+			LogHandler: l,
+			Policy:     &QueryPolicy{DenyUnboundedWrites: true, ReportOnly: reportOnly},
+		}))
+
+		_, err := db.Exec("DELETE FROM t")
+
+		var policyErr *PolicyError
+		if rejected := errors.As(err, &policyErr); rejected == reportOnly {
+			t.Errorf("report only %t: unexpected error %v", reportOnly, err)
+		}
+		if !reportOnly && (policyErr.Violation != ViolationUnboundedWrite || !errors.Is(err, ErrPolicyViolation)) {
+			t.Errorf("expected unbounded write violation, got %v", err)
+		}
+
+		expected := []string{"reject unbounded write"}
+		executed := 0
+		if reportOnly {
+			expected, executed = []string{"report unbounded write"}, 1
+		}
+		if !slices.Equal(l.violations, expected) {
+			t.Errorf("report only %t: expected violations %q, got %q", reportOnly, expected, l.violations)
+		}
+		if events := l.take(); events["Exec"] != executed {
+			t.Errorf("report only %t: expected %d executed statements, got %d", reportOnly, executed, events["Exec"])
+		}
+
+		_ = db.Close()
+	}
+}
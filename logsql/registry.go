@@ -0,0 +1,304 @@
+package logsql
+
+import (
+	"cmp"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// ActiveExec is Exec call in progress
+	ActiveExec ActiveKind = iota
+	// ActiveQuery is Query call in progress
+	ActiveQuery
+	// ActiveRows are rows of finished Query call that are not closed yet
+	ActiveRows
+	// ActiveTx is transaction that is not committed or rolled back yet
+	ActiveTx
+)
+
+const (
+	// RegistryCancelHeader is a header required by cancellation requests of Registry.Handler
+	RegistryCancelHeader = "X-Registry-Cancel"
+)
+
+var (
+	_ http.Handler = (*registryHandler)(nil)
+)
+
+type (
+	// ActiveKind is a kind of operation tracked by Registry
+	ActiveKind uint8
+
+	// Registry tracks in-flight operations of logged connections. Set it to Config.Registry to apply it to connector.
+	// Only context-aware calls are tracked and can be canceled. The same registry can be shared by several
+	// connectors. It is safe for concurrent use
+	Registry struct {
+		nextID     atomic.Uint64
+		nextConnID atomic.Uint64
+
+		mu  sync.Mutex
+		ops map[uint64]*activeOp
+	}
+
+	// ActiveOp is a snapshot of operation tracked by Registry. TxID is zero outside transactions
+	ActiveOp struct {
+		ID       uint64              `json:"id"`
+		Kind     ActiveKind          `json:"kind"`
+		Query    string              `json:"query,omitempty"`
+		Args     []driver.NamedValue `json:"args,omitempty"`
+		Start    time.Time           `json:"start"`
+		Duration time.Duration       `json:"duration"`
+		ConnID   uint64              `json:"conn_id"`
+		TxID     uint64              `json:"tx_id,omitempty"`
+		Caller   string              `json:"caller,omitempty"`
+	}
+
+	activeOp struct {
+		registry *Registry
+		op       ActiveOp
+		cancel   context.CancelCauseFunc
+	}
+
+	registryHandler struct {
+		registry    *Registry
+		allowCancel bool
+	}
+)
+
+var registryTemplate = template.Must(template.New("registry").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Active database operations</title></head>
+<body>
+<h1>Active database operations: {{len .Ops}}</h1>
+<table border="1" cellpadding="4">
+<tr><th>ID</th><th>Kind</th><th>Duration</th><th>Conn</th><th>Tx</th><th>Query</th><th>Args</th><th>Caller</th>{{if .AllowCancel}}<th></th>{{end}}</tr>
+{{range .Ops}}<tr>
+<td>{{.ID}}</td><td>{{.Kind}}</td><td>{{.Duration}}</td><td>{{.ConnID}}</td><td>{{if .TxID}}{{.TxID}}{{end}}</td>
+<td><pre>{{.Query}}</pre></td><td>{{range .Args}}{{.Value}}<br>{{end}}</td><td>{{.Caller}}</td>
+{{if $.AllowCancel}}<td><button onclick="cancelOp({{.ID}})">Cancel</button></td>{{end}}
+</tr>{{end}}
+</table>
+{{if .AllowCancel}}<script>
+function cancelOp(id) {
+	fetch(location.href, {
+		method: "POST",
+		headers: {"{{.CancelHeader}}": "1"},
+		body: new URLSearchParams({cancel: id}),
+	}).then(() => location.reload());
+}
+</script>{{end}}
+</body>
+</html>
+`))
+
+// NewRegistry returns new empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		ops: make(map[uint64]*activeOp),
+	}
+}
+
+func (k ActiveKind) String() string {
+	switch k {
+	case ActiveExec:
+		return "exec"
+	case ActiveQuery:
+		return "query"
+	case ActiveRows:
+		return "rows"
+	case ActiveTx:
+		return "tx"
+	default:
+		return "unknown"
+	}
+}
+
+func (k ActiveKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Active returns snapshot of tracked operations ordered by start time
+func (r *Registry) Active() []ActiveOp {
+	r.mu.Lock()
+	ops := make([]ActiveOp, 0, len(r.ops))
+	for _, op := range r.ops {
+		ops = append(ops, op.op)
+	}
+	r.mu.Unlock()
+
+	now := time.Now()
+	for i := range ops {
+		ops[i].Duration = now.Sub(ops[i].Start)
+	}
+
+	slices.SortFunc(ops, func(a ActiveOp, b ActiveOp) int {
+		return cmp.Or(a.Start.Compare(b.Start), cmp.Compare(a.ID, b.ID))
+	})
+
+	return ops
+}
+
+// Cancel cancels context of tracked operation with ErrCanceledByRegistry cause. Canceling rows or transaction
+// cancels context of Query or BeginTx call respectively. Returns false if there is no such operation
+func (r *Registry) Cancel(id uint64) bool {
+	r.mu.Lock()
+	op, ok := r.ops[id]
+	r.mu.Unlock()
+
+	if ok {
+		op.cancel(ErrCanceledByRegistry)
+	}
+
+	return ok
+}
+
+// Handler returns [http.Handler] that shows tracked operations as HTML table, or as JSON if request has query
+// parameter format=json or Accept header with application/json. Arguments are redacted: nil, boolean, numeric and
+// time values are kept, others are replaced with "[redacted]". If allowCancel is true, POST request with form value
+// cancel=<id> and RegistryCancelHeader header cancels operation. Browsers do not send custom headers cross-origin
+// without CORS approval, so the header protects cancellation from cross-site requests
+func (r *Registry) Handler(allowCancel bool) http.Handler {
+	return &registryHandler{
+		registry:    r,
+		allowCancel: allowCancel,
+	}
+}
+
+// track registers operation and returns context of it that is canceled by Cancel. Returned operation must be
+// finished with done. Nil registry does not track anything
+func (r *Registry) track(ctx context.Context, kind ActiveKind, c *connection, query string,
+	args []driver.NamedValue) (context.Context, *activeOp) {
+	if r == nil {
+		return ctx, nil
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	op := &activeOp{
+		registry: r,
+		op: ActiveOp{
+			ID:     r.nextID.Add(1),
+			Kind:   kind,
+			Query:  query,
+			Args:   args,
+			Start:  time.Now(),
+			ConnID: c.id,
+			TxID:   c.txID,
+			Caller: callerOutside(),
+		},
+		cancel: cancel,
+	}
+
+	r.mu.Lock()
+	r.ops[op.op.ID] = op
+	r.mu.Unlock()
+
+	return ctx, op
+}
+
+// setKind changes kind of operation, e.g. when Query call returns rows
+func (o *activeOp) setKind(kind ActiveKind) {
+	if o == nil {
+		return
+	}
+
+	o.registry.mu.Lock()
+	o.op.Kind = kind
+	o.registry.mu.Unlock()
+}
+
+// done unregisters operation and releases its context
+func (o *activeOp) done() {
+	if o == nil {
+		return
+	}
+
+	o.registry.mu.Lock()
+	delete(o.registry.ops, o.op.ID)
+	o.registry.mu.Unlock()
+
+	o.cancel(nil)
+}
+
+func (h *registryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPost {
+		if !h.allowCancel {
+			http.Error(w, "cancellation is not allowed", http.StatusForbidden)
+			return
+		}
+		if r.Header.Get(RegistryCancelHeader) == "" {
+			http.Error(w, "missing "+RegistryCancelHeader+" header", http.StatusForbidden)
+			return
+		}
+
+		id, err := strconv.ParseUint(r.FormValue("cancel"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid operation id", http.StatusBadRequest)
+			return
+		}
+
+		if !h.registry.Cancel(id) {
+			http.Error(w, "operation not found", http.StatusNotFound)
+			return
+		}
+
+		if !wantsJSON(r) {
+			http.Redirect(w, r, r.URL.String(), http.StatusSeeOther)
+		}
+		return
+	}
+
+	ops := h.registry.Active()
+	for i := range ops {
+		ops[i].Args = redactArgs(ops[i].Args)
+	}
+
+	if wantsJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ops)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = registryTemplate.Execute(w, struct {
+		Ops          []ActiveOp
+		AllowCancel  bool
+		CancelHeader string
+	}{
+		Ops:          ops,
+		AllowCancel:  h.allowCancel,
+		CancelHeader: RegistryCancelHeader,
+	})
+}
+
+// redactArgs returns copy of args with values other than nil, boolean, numeric and time replaced with "[redacted]"
+func redactArgs(args []driver.NamedValue) []driver.NamedValue {
+	if args == nil {
+		return nil
+	}
+
+	redacted := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		switch arg.Value.(type) {
+		case nil, bool, int64, float64, time.Time:
+		default:
+			arg.Value = "[redacted]"
+		}
+		redacted[i] = arg
+	}
+
+	return redacted
+}
+
+func wantsJSON(r *http.Request) bool {
+	return r.URL.Query().Get("format") == "json" || strings.Contains(r.Header.Get("Accept"), "application/json")
+}
@@ -0,0 +1,52 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestRegistryHandler(t *testing.T) {
+	r := NewRegistry()
+	ctx, op := r.track(context.Background(), ActiveQuery, &connection{}, "SELECT a FROM t WHERE b = $1 AND c = $2",
+		[]driver.NamedValue{{Ordinal: 1, Value: "secret"}, {Ordinal: 2, Value: int64(42)}})
+	defer op.done()
+
+	h := r.Handler(true)
+
+	for _, format := range []string{"json", "html"} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?format="+format, nil))
+		if body := w.Body.String(); strings.Contains(body, "secret") || !strings.Contains(body, "42") {
+			t.Errorf("expected %s arguments to be redacted, got %s", format, body)
+		}
+	}
+
+	cancel := func(header bool) int {
+		form := url.Values{"cancel": {strconv.FormatUint(op.op.ID, 10)}}
+		req := httptest.NewRequest(http.MethodPost, "/?format=json", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if header {
+			req.Header.Set(RegistryCancelHeader, "1")
+		}
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	if code := cancel(false); code != http.StatusForbidden || ctx.Err() != nil {
+		t.Errorf("expected cancellation without header to be forbidden, got %d", code)
+	}
+	if code := cancel(true); code != http.StatusOK || ctx.Err() == nil {
+		t.Errorf("expected operation to be canceled, got %d", code)
+	}
+	if op.op.Args[0].Value != "secret" {
+		t.Errorf("expected tracked arguments not to be modified, got %v", op.op.Args)
+	}
+}
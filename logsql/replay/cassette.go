@@ -0,0 +1,122 @@
+package replay
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// CassetteVersion is a version of cassette format written by Cassette.Write
+	CassetteVersion = 1
+
+	InteractionExec  = "exec"
+	InteractionQuery = "query"
+)
+
+type (
+	// Cassette is a portable recording of database traffic
+	Cassette struct {
+		Version      int           `json:"version"`
+		Interactions []Interaction `json:"interactions"`
+	}
+
+	// Interaction is a single recorded Exec or Query. Fingerprint (see [logsql.Fingerprint]) is informational, queries
+	// are replayed by Query. Err is a text of error returned by the database, if any.
+	// For queries ResultSets contains returned columns and rows, for execs LastInsertId and RowsAffected are filled
+	Interaction struct {
+		Kind         string      `json:"kind"`
+		Query        string      `json:"query"`
+		Fingerprint  string      `json:"fingerprint"`
+		Args         []Value     `json:"args"`
+		Err          string      `json:"err,omitempty"`
+		ResultSets   []ResultSet `json:"resultSets,omitempty"`
+		LastInsertId int64       `json:"lastInsertId,omitempty"`
+		RowsAffected int64       `json:"rowsAffected,omitempty"`
+	}
+
+	// ResultSet contains columns and rows of a single result set. NextErr is a text of error returned by
+	// [driver.Rows] Next instead of [io.EOF], if any
+	ResultSet struct {
+		Columns []string  `json:"columns"`
+		Rows    [][]Value `json:"rows"`
+		NextErr string    `json:"nextErr,omitempty"`
+	}
+)
+
+// Read decodes Cassette from r
+func Read(r io.Reader) (*Cassette, error) {
+	var c Cassette
+	err := json.NewDecoder(r).Decode(&c)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Version != CassetteVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, c.Version)
+	}
+
+	return &c, nil
+}
+
+// ReadFile decodes Cassette from file
+func ReadFile(name string) (*Cassette, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// Write encodes Cassette into w
+func (c *Cassette) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(c)
+}
+
+// WriteFile encodes Cassette into file, file is created or truncated
+func (c *Cassette) WriteFile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	err = c.Write(f)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func errorText(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+// errorFromText restores well known errors, other errors are returned as *RecordedError
+func errorFromText(text string) error {
+	switch text {
+	case "":
+		return nil
+	case driver.ErrBadConn.Error():
+		return driver.ErrBadConn
+	case context.Canceled.Error():
+		return context.Canceled
+	case context.DeadlineExceeded.Error():
+		return context.DeadlineExceeded
+	default:
+		return &RecordedError{Message: text}
+	}
+}
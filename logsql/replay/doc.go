@@ -0,0 +1,19 @@
+// Package replay records database traffic into a portable Cassette and replays it later without a database.
+//
+// Recorder wraps [driver.Connector] of a real database. Place it under [logsql] connector, so the traffic is logged
+// as usual while being recorded:
+//
+//	rec := replay.NewRecorder(connector)
+//	db := sql.OpenDB(logsql.NewConnectorFromConnector(rec, cfg))
+//	// ... run the code
+//	err := rec.Cassette().WriteFile("testdata/users.json")
+//
+// Replayer is a [driver.Connector] that answers queries with recorded interactions. Queries are matched by exact text
+// and arguments, every interaction is consumed once in recorded order:
+//
+//	cassette, err := replay.ReadFile("testdata/users.json")
+//	rp := replay.NewReplayer(cassette)
+//	db := sql.OpenDB(logsql.NewConnectorFromConnector(rp, cfg))
+//	// ... run the code
+//	err = rp.Check() // reports unmatched queries and unconsumed interactions
+package replay
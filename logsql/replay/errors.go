@@ -0,0 +1,23 @@
+package replay
+
+import (
+	"errors"
+)
+
+var (
+	ErrUnmatchedQuery        = errors.New("query does not match any recorded interaction")
+	ErrUnconsumedInteraction = errors.New("recorded interaction was not consumed")
+	ErrUnsupportedVersion    = errors.New("unsupported cassette version")
+	ErrUnsupportedValueType  = errors.New("unsupported value type")
+)
+
+type (
+	// RecordedError is returned by Replayer instead of an error that was returned by the database during recording
+	RecordedError struct {
+		Message string
+	}
+)
+
+func (e *RecordedError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,259 @@
+package replay
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+var (
+	_ driver.Conn               = (*recordedConn)(nil)
+	_ driver.ConnBeginTx        = (*recordedConn)(nil)
+	_ driver.ConnPrepareContext = (*recordedConn)(nil)
+	_ driver.ExecerContext      = (*recordedConn)(nil)
+	_ driver.QueryerContext     = (*recordedConn)(nil)
+	_ driver.Pinger             = (*recordedConn)(nil)
+	_ driver.SessionResetter    = (*recordedConn)(nil)
+	_ driver.Validator          = (*recordedConn)(nil)
+	_ driver.NamedValueChecker  = (*recordedConn)(nil)
+
+	_ driver.Stmt              = (*recordedStmt)(nil)
+	_ driver.StmtExecContext   = (*recordedStmt)(nil)
+	_ driver.StmtQueryContext  = (*recordedStmt)(nil)
+	_ driver.NamedValueChecker = (*recordedStmt)(nil)
+	_ driver.ColumnConverter   = (*recordedStmt)(nil)
+
+	_ driver.Rows              = (*recordedRows)(nil)
+	_ driver.RowsNextResultSet = (*recordedRows)(nil)
+)
+
+type (
+	recordedConn struct {
+		recorder *Recorder
+		conn     driver.Conn
+	}
+
+	recordedStmt struct {
+		recorder *Recorder
+		query    string
+		stmt     driver.Stmt
+	}
+
+	recordedRows struct {
+		recorder    *Recorder
+		interaction *Interaction
+		rows        driver.Rows
+	}
+)
+
+func (c *recordedConn) Prepare(query string) (driver.Stmt, error) {
+	stmt, err := c.conn.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordedStmt{recorder: c.recorder, query: query, stmt: stmt}, nil
+}
+
+func (c *recordedConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *recordedConn) Begin() (driver.Tx, error) {
+	return c.conn.Begin()
+}
+
+func (c *recordedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	connBeginTx, ok := c.conn.(driver.ConnBeginTx)
+	if !ok {
+		return c.Begin()
+	}
+
+	return connBeginTx.BeginTx(ctx, opts)
+}
+
+func (c *recordedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	connPrepareCtx, ok := c.conn.(driver.ConnPrepareContext)
+	if !ok {
+		return c.Prepare(query)
+	}
+
+	stmt, err := connPrepareCtx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordedStmt{recorder: c.recorder, query: query, stmt: stmt}, nil
+}
+
+func (c *recordedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	var result driver.Result
+	var err error
+
+	switch conn := c.conn.(type) {
+	case driver.ExecerContext:
+		result, err = conn.ExecContext(ctx, query, args)
+	case driver.Execer:
+		result, err = conn.Exec(query, namedToValues(args))
+	default:
+		return nil, driver.ErrSkip
+	}
+
+	c.recorder.recordResult(InteractionExec, query, args, result, err)
+
+	return result, err
+}
+
+func (c *recordedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	var rows driver.Rows
+	var err error
+
+	switch conn := c.conn.(type) {
+	case driver.QueryerContext:
+		rows, err = conn.QueryContext(ctx, query, args)
+	case driver.Queryer:
+		rows, err = conn.Query(query, namedToValues(args))
+	default:
+		return nil, driver.ErrSkip
+	}
+
+	return c.recorder.recordRows(InteractionQuery, query, args, rows, err), err
+}
+
+func (c *recordedConn) Ping(ctx context.Context) error {
+	connPinger, ok := c.conn.(driver.Pinger)
+	if !ok {
+		return logsql.ErrUnsupportedByDriver
+	}
+
+	return connPinger.Ping(ctx)
+}
+
+func (c *recordedConn) ResetSession(ctx context.Context) error {
+	connSessionResetter, ok := c.conn.(driver.SessionResetter)
+	if !ok {
+		return nil
+	}
+
+	return connSessionResetter.ResetSession(ctx)
+}
+
+func (c *recordedConn) IsValid() bool {
+	connValidator, ok := c.conn.(driver.Validator)
+	if !ok {
+		return true
+	}
+
+	return connValidator.IsValid()
+}
+
+func (c *recordedConn) CheckNamedValue(value *driver.NamedValue) error {
+	connValueChecker, ok := c.conn.(driver.NamedValueChecker)
+	if !ok {
+		return driver.ErrSkip
+	}
+
+	return connValueChecker.CheckNamedValue(value)
+}
+
+func (s *recordedStmt) Close() error {
+	return s.stmt.Close()
+}
+
+func (s *recordedStmt) NumInput() int {
+	return s.stmt.NumInput()
+}
+
+func (s *recordedStmt) Exec(args []driver.Value) (driver.Result, error) {
+	result, err := s.stmt.Exec(args)
+	s.recorder.recordResult(InteractionExec, s.query, valuesToNamed(args), result, err)
+
+	return result, err
+}
+
+func (s *recordedStmt) Query(args []driver.Value) (driver.Rows, error) {
+	rows, err := s.stmt.Query(args)
+
+	return s.recorder.recordRows(InteractionQuery, s.query, valuesToNamed(args), rows, err), err
+}
+
+func (s *recordedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	stExecerCtx, ok := s.stmt.(driver.StmtExecContext)
+	if !ok {
+		return s.Exec(namedToValues(args))
+	}
+
+	result, err := stExecerCtx.ExecContext(ctx, args)
+	s.recorder.recordResult(InteractionExec, s.query, args, result, err)
+
+	return result, err
+}
+
+func (s *recordedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	stQueryerCtx, ok := s.stmt.(driver.StmtQueryContext)
+	if !ok {
+		return s.Query(namedToValues(args))
+	}
+
+	rows, err := stQueryerCtx.QueryContext(ctx, args)
+
+	return s.recorder.recordRows(InteractionQuery, s.query, args, rows, err), err
+}
+
+func (s *recordedStmt) CheckNamedValue(value *driver.NamedValue) error {
+	stValueChecker, ok := s.stmt.(driver.NamedValueChecker)
+	if !ok {
+		return driver.ErrSkip
+	}
+
+	return stValueChecker.CheckNamedValue(value)
+}
+
+func (s *recordedStmt) ColumnConverter(idx int) driver.ValueConverter {
+	stColumnConverter, ok := s.stmt.(driver.ColumnConverter)
+	if !ok {
+		return driver.DefaultParameterConverter
+	}
+
+	return stColumnConverter.ColumnConverter(idx)
+}
+
+func (r *recordedRows) Columns() []string {
+	return r.rows.Columns()
+}
+
+func (r *recordedRows) Close() error {
+	return r.rows.Close()
+}
+
+func (r *recordedRows) Next(dest []driver.Value) error {
+	err := r.rows.Next(dest)
+	r.recorder.appendRow(r.interaction, dest, err)
+
+	return err
+}
+
+func (r *recordedRows) HasNextResultSet() bool {
+	rs, ok := r.rows.(driver.RowsNextResultSet)
+	if !ok {
+		return false
+	}
+
+	return rs.HasNextResultSet()
+}
+
+func (r *recordedRows) NextResultSet() error {
+	rs, ok := r.rows.(driver.RowsNextResultSet)
+	if !ok {
+		return io.EOF
+	}
+
+	err := rs.NextResultSet()
+	if err == nil {
+		r.recorder.appendResultSet(r.interaction, r.rows.Columns())
+	}
+
+	return err
+}
@@ -0,0 +1,144 @@
+package replay
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+var (
+	_ driver.Connector = (*Recorder)(nil)
+)
+
+type (
+	// Recorder is a [driver.Connector] that records every Exec and Query passing through connections of underlying
+	// connector. It is safe for concurrent use
+	Recorder struct {
+		connector driver.Connector
+
+		mu           sync.Mutex
+		interactions []*Interaction
+	}
+)
+
+// NewRecorder returns new Recorder based on existing connector
+func NewRecorder(connector driver.Connector) *Recorder {
+	return &Recorder{
+		connector: connector,
+	}
+}
+
+func (r *Recorder) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := r.connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordedConn{
+		recorder: r,
+		conn:     conn,
+	}, nil
+}
+
+func (r *Recorder) Driver() driver.Driver {
+	return r.connector.Driver()
+}
+
+// Cassette returns snapshot of interactions recorded so far
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := &Cassette{
+		Version:      CassetteVersion,
+		Interactions: make([]Interaction, len(r.interactions)),
+	}
+	for i, it := range r.interactions {
+		c.Interactions[i] = *it
+		c.Interactions[i].ResultSets = append([]ResultSet(nil), it.ResultSets...)
+	}
+
+	return c
+}
+
+// record saves the outcome of Exec or Query. Interactions that were skipped by the driver with [driver.ErrSkip] are
+// not recorded, since [database/sql] will retry them in another way
+func (r *Recorder) record(kind, query string, args []driver.NamedValue, err error) *Interaction {
+	if errors.Is(err, driver.ErrSkip) {
+		return nil
+	}
+
+	it := &Interaction{
+		Kind:        kind,
+		Query:       query,
+		Fingerprint: logsql.Fingerprint(query),
+		Args:        toValues(namedToValues(args)),
+		Err:         errorText(err),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.interactions = append(r.interactions, it)
+
+	return it
+}
+
+func (r *Recorder) recordResult(kind, query string, args []driver.NamedValue, result driver.Result, err error) {
+	it := r.record(kind, query, args, err)
+	if it == nil || err != nil {
+		return
+	}
+
+	lastInsertId, _ := result.LastInsertId()
+	rowsAffected, _ := result.RowsAffected()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	it.LastInsertId = lastInsertId
+	it.RowsAffected = rowsAffected
+}
+
+func (r *Recorder) recordRows(kind, query string, args []driver.NamedValue, rows driver.Rows, err error) driver.Rows {
+	it := r.record(kind, query, args, err)
+	if it == nil || err != nil {
+		return rows
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	it.ResultSets = []ResultSet{{Columns: rows.Columns()}}
+
+	return &recordedRows{
+		recorder:    r,
+		interaction: it,
+		rows:        rows,
+	}
+}
+
+func (r *Recorder) appendRow(it *Interaction, dest []driver.Value, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	set := &it.ResultSets[len(it.ResultSets)-1]
+	switch {
+	case err == nil:
+		set.Rows = append(set.Rows, toValues(dest))
+	case !errors.Is(err, io.EOF):
+		set.NextErr = err.Error()
+	default:
+	}
+}
+
+func (r *Recorder) appendResultSet(it *Interaction, columns []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	it.ResultSets = append(it.ResultSets, ResultSet{Columns: columns})
+}
@@ -0,0 +1,168 @@
+package replay
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+var (
+	_ driver.Connector = (*Replayer)(nil)
+)
+
+type (
+	// Replayer is a [driver.Connector] that answers queries with interactions of Cassette. Query matches interaction
+	// if their texts and arguments are equal. Every interaction is consumed once, interactions with the same query
+	// and arguments are consumed in recorded order. Queries that do not match any interaction fail with
+	// ErrUnmatchedQuery. It is safe for concurrent use
+	Replayer struct {
+		cassette *Cassette
+		drv      *fakedriver.Driver
+
+		mu        sync.Mutex
+		consumed  []bool
+		unmatched []UnmatchedQuery
+	}
+
+	// UnmatchedQuery is a query that did not match any interaction
+	UnmatchedQuery struct {
+		Query string
+		Args  []driver.Value
+	}
+)
+
+// NewReplayer returns new Replayer for cassette
+func NewReplayer(cassette *Cassette) *Replayer {
+	rp := &Replayer{
+		cassette: cassette,
+		consumed: make([]bool, len(cassette.Interactions)),
+	}
+
+	rules := make([]fakedriver.Rule, 0, len(cassette.Interactions)+1)
+	for i, it := range cassette.Interactions {
+		rules = append(rules, fakedriver.Rule{
+			Match:    rp.matcher(i, it.Query, it.Args),
+			Times:    1,
+			Response: toResponse(it),
+		})
+	}
+	rules = append(rules, fakedriver.Rule{
+		Match:    rp.unmatchedMatcher,
+		Response: fakedriver.Response{Err: ErrUnmatchedQuery},
+	})
+
+	rp.drv = fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules:    rules,
+	})
+
+	return rp
+}
+
+func (rp *Replayer) Connect(ctx context.Context) (driver.Conn, error) {
+	return rp.drv.Connect(ctx)
+}
+
+func (rp *Replayer) Driver() driver.Driver {
+	return rp.drv
+}
+
+// Unmatched returns queries that did not match any interaction
+func (rp *Replayer) Unmatched() []UnmatchedQuery {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	return append([]UnmatchedQuery(nil), rp.unmatched...)
+}
+
+// Unconsumed returns interactions that were not consumed by any query
+func (rp *Replayer) Unconsumed() []Interaction {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	var unconsumed []Interaction
+	for i, consumed := range rp.consumed {
+		if !consumed {
+			unconsumed = append(unconsumed, rp.cassette.Interactions[i])
+		}
+	}
+
+	return unconsumed
+}
+
+// Check returns error describing every unmatched query and unconsumed interaction, nil if there are none
+func (rp *Replayer) Check() error {
+	var errs []error
+	for _, q := range rp.Unmatched() {
+		errs = append(errs, fmt.Errorf("%w: %s %v", ErrUnmatchedQuery, q.Query, q.Args))
+	}
+	for _, it := range rp.Unconsumed() {
+		errs = append(errs, fmt.Errorf("%w: %s %v", ErrUnconsumedInteraction, it.Query, fromValues(it.Args)))
+	}
+
+	return errors.Join(errs...)
+}
+
+// matcher is called by fakedriver in order of rules, so interaction is consumed as soon as it is matched
+func (rp *Replayer) matcher(i int, recorded string, args []Value) func(string, []driver.NamedValue) bool {
+	return func(query string, namedArgs []driver.NamedValue) bool {
+		if len(args) != len(namedArgs) || query != recorded {
+			return false
+		}
+		for j := range args {
+			if !args[j].equal(namedArgs[j].Value) {
+				return false
+			}
+		}
+
+		rp.mu.Lock()
+		defer rp.mu.Unlock()
+
+		rp.consumed[i] = true
+
+		return true
+	}
+}
+
+func (rp *Replayer) unmatchedMatcher(query string, args []driver.NamedValue) bool {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	rp.unmatched = append(rp.unmatched, UnmatchedQuery{
+		Query: query,
+		Args:  namedToValues(args),
+	})
+
+	return true
+}
+
+func toResponse(it Interaction) fakedriver.Response {
+	resp := fakedriver.Response{
+		LastInsertId: it.LastInsertId,
+		RowsAffected: it.RowsAffected,
+		Err:          errorFromText(it.Err),
+	}
+
+	for i, set := range it.ResultSets {
+		r := fakedriver.Response{
+			Columns: set.Columns,
+			Rows:    make([][]driver.Value, len(set.Rows)),
+			NextErr: errorFromText(set.NextErr),
+		}
+		for j, row := range set.Rows {
+			r.Rows[j] = fromValues(row)
+		}
+
+		if i == 0 {
+			resp.Columns, resp.Rows, resp.NextErr = r.Columns, r.Rows, r.NextErr
+		} else {
+			resp.NextResultSets = append(resp.NextResultSets, r)
+		}
+	}
+
+	return resp
+}
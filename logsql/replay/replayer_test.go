@@ -0,0 +1,169 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+	"github.com/alsiberij/sqlutils/logsql"
+	"github.com/alsiberij/sqlutils/logsql/logsqltest"
+)
+
+var (
+	errDuplicate = errors.New("duplicate key value")
+	createdAt    = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+)
+
+// workload runs queries against db and returns description of their outcomes
+func workload(ctx context.Context, db *sql.DB) ([]string, error) {
+	var outcomes []string
+
+	rows, err := db.QueryContext(ctx, "SELECT id, name, avatar, created_at, deleted_at FROM users WHERE id = $1", 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		for rows.Next() {
+			var id, name, avatar, createdAt, deletedAt any
+			if err = rows.Scan(&id, &name, &avatar, &createdAt, &deletedAt); err != nil {
+				return nil, err
+			}
+			outcomes = append(outcomes, fmt.Sprintf("row %v %v %v %v %v", id, name, avatar, createdAt, deletedAt))
+		}
+		if !rows.NextResultSet() {
+			break
+		}
+		outcomes = append(outcomes, "next result set")
+	}
+	if err = rows.Close(); err != nil {
+		return nil, err
+	}
+
+	result, err := db.ExecContext(ctx, "INSERT INTO users (name) VALUES ($1)", []byte{0xff, 0x00})
+	if err != nil {
+		return nil, err
+	}
+	id, _ := result.LastInsertId()
+	affected, _ := result.RowsAffected()
+	outcomes = append(outcomes, fmt.Sprintf("insert %d %d", id, affected))
+
+	_, err = db.ExecContext(ctx, "INSERT INTO users (name) VALUES ($1)", "taken")
+	outcomes = append(outcomes, fmt.Sprintf("insert %v", err))
+
+	return outcomes, nil
+}
+
+func TestRoundTrip(t *testing.T) {
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{
+			{
+				Pattern: regexp.MustCompile("^SELECT"),
+				Response: fakedriver.Response{
+					Columns: []string{"id", "name", "avatar", "created_at", "deleted_at"},
+					Rows: [][]driver.Value{
+						{int64(1), "john", []byte("héllo"), createdAt, nil},
+						{int64(2), "jane", []byte{0x00}, createdAt, createdAt},
+					},
+					NextResultSets: []fakedriver.Response{{
+						Columns: []string{"id", "name", "avatar", "created_at", "deleted_at"},
+						Rows:    [][]driver.Value{{int64(3), "joe", nil, createdAt, nil}},
+					}},
+				},
+			},
+			{
+				Args:     []driver.Value{"taken"},
+				Response: fakedriver.Response{Err: errDuplicate},
+			},
+			{
+				Pattern:  regexp.MustCompile("^INSERT"),
+				Response: fakedriver.Response{LastInsertId: 5, RowsAffected: 1},
+			},
+		},
+	})
+
+	ctx := context.Background()
+	rec := NewRecorder(d)
+	db := sql.OpenDB(logsql.NewConnectorFromConnector(rec, logsql.Config{LogHandler: logsqltest.NewRecorder()}))
+	recorded, err := workload(ctx, db)
+	_ = db.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(recorded) != 6 || recorded[5] != "insert "+errDuplicate.Error() {
+		t.Fatalf("unexpected recorded outcomes %q", recorded)
+	}
+
+	var buf bytes.Buffer
+	if err = rec.Cassette().Write(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cassette, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rp := NewReplayer(cassette)
+	db = sql.OpenDB(logsql.NewConnectorFromConnector(rp, logsql.Config{LogHandler: logsqltest.NewRecorder()}))
+	defer db.Close()
+
+	replayed, err := workload(ctx, db)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(recorded, replayed) {
+		t.Errorf("expected replayed outcomes\n%q\ngot\n%q", recorded, replayed)
+	}
+	if err = rp.Check(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestReplayMatchesQueryText(t *testing.T) {
+	rp := NewReplayer(&Cassette{
+		Version: CassetteVersion,
+		Interactions: []Interaction{{
+			Kind:         InteractionExec,
+			Query:        "DELETE FROM users WHERE id = 1",
+			Fingerprint:  logsql.Fingerprint("DELETE FROM users WHERE id = 1"),
+			RowsAffected: 1,
+		}},
+	})
+	db := sql.OpenDB(logsql.NewConnectorFromConnector(rp, logsql.Config{LogHandler: logsqltest.NewRecorder()}))
+	defer db.Close()
+
+	_, err := db.Exec("DELETE FROM users WHERE id = 2")
+	if !errors.Is(err, ErrUnmatchedQuery) {
+		t.Errorf("expected query with the same fingerprint but another text to be unmatched, got %v", err)
+	}
+
+	if _, err = db.Exec("DELETE FROM users WHERE id = 1"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if unmatched := rp.Unmatched(); len(unmatched) != 1 || unmatched[0].Query != "DELETE FROM users WHERE id = 2" {
+		t.Errorf("expected one unmatched query, got %v", unmatched)
+	}
+}
+
+func TestRecorderPing(t *testing.T) {
+	rec := NewRecorder(fakedriver.New(fakedriver.Config{Features: fakedriver.FeatureAll &^ fakedriver.FeaturePinger}))
+
+	conn, err := rec.Connect(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer conn.Close()
+
+	if err = conn.(driver.Pinger).Ping(context.Background()); !errors.Is(err, logsql.ErrUnsupportedByDriver) {
+		t.Errorf("expected %v, got %v", logsql.ErrUnsupportedByDriver, err)
+	}
+}
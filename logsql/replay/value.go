@@ -0,0 +1,146 @@
+package replay
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	valueTypeNull    = "null"
+	valueTypeInt64   = "int64"
+	valueTypeFloat64 = "float64"
+	valueTypeBool    = "bool"
+	valueTypeBytes   = "bytes"
+	valueTypeString  = "string"
+	valueTypeTime    = "time"
+)
+
+type (
+	// Value is a [driver.Value] that keeps its type when encoded to JSON. Values of types other than ones
+	// described in [driver.Value] are recorded as strings
+	Value struct {
+		V driver.Value
+	}
+
+	jsonValue struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value,omitempty"`
+	}
+)
+
+func (v Value) MarshalJSON() ([]byte, error) {
+	var typ string
+	var val any
+
+	switch x := v.V.(type) {
+	case nil:
+		typ = valueTypeNull
+	case int64:
+		typ, val = valueTypeInt64, x
+	case float64:
+		typ, val = valueTypeFloat64, x
+	case bool:
+		typ, val = valueTypeBool, x
+	case []byte:
+		typ, val = valueTypeBytes, base64.StdEncoding.EncodeToString(x)
+	case string:
+		typ, val = valueTypeString, x
+	case time.Time:
+		typ, val = valueTypeTime, x.Format(time.RFC3339Nano)
+	default:
+		typ, val = valueTypeString, fmt.Sprint(x)
+	}
+
+	jv := jsonValue{Type: typ}
+	if val != nil {
+		raw, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		jv.Value = raw
+	}
+
+	return json.Marshal(jv)
+}
+
+func (v *Value) UnmarshalJSON(data []byte) error {
+	var jv jsonValue
+	err := json.Unmarshal(data, &jv)
+	if err != nil {
+		return err
+	}
+
+	switch jv.Type {
+	case valueTypeNull:
+		v.V = nil
+		return nil
+	case valueTypeInt64:
+		var x int64
+		err = json.Unmarshal(jv.Value, &x)
+		v.V = x
+	case valueTypeFloat64:
+		var x float64
+		err = json.Unmarshal(jv.Value, &x)
+		v.V = x
+	case valueTypeBool:
+		var x bool
+		err = json.Unmarshal(jv.Value, &x)
+		v.V = x
+	case valueTypeBytes:
+		var s string
+		err = json.Unmarshal(jv.Value, &s)
+		if err == nil {
+			v.V, err = base64.StdEncoding.DecodeString(s)
+		}
+	case valueTypeString:
+		var x string
+		err = json.Unmarshal(jv.Value, &x)
+		v.V = x
+	case valueTypeTime:
+		var s string
+		err = json.Unmarshal(jv.Value, &s)
+		if err == nil {
+			v.V, err = time.Parse(time.RFC3339Nano, s)
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedValueType, jv.Type)
+	}
+
+	return err
+}
+
+// equal compares values, time.Time values are compared by time.Time.Equal
+func (v Value) equal(x driver.Value) bool {
+	switch a := v.V.(type) {
+	case time.Time:
+		b, ok := x.(time.Time)
+		return ok && a.Equal(b)
+	case []byte:
+		b, ok := x.([]byte)
+		return ok && string(a) == string(b)
+	default:
+		return v.V == x
+	}
+}
+
+func toValues(values []driver.Value) []Value {
+	result := make([]Value, len(values))
+	for i, v := range values {
+		if b, ok := v.([]byte); ok {
+			v = append([]byte(nil), b...)
+		}
+		result[i] = Value{V: v}
+	}
+	return result
+}
+
+func fromValues(values []Value) []driver.Value {
+	result := make([]driver.Value, len(values))
+	for i, v := range values {
+		result[i] = v.V
+	}
+	return result
+}
@@ -0,0 +1,288 @@
+package logsql
+
+import (
+	"cmp"
+	"container/list"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	_ driver.Rows = (*cachedRows)(nil)
+	_ driver.Rows = (*bufferedRows)(nil)
+)
+
+type (
+	// ResultCacheConfig configures ResultCache. Results of queries with context marked by WithResultCache or
+	// matching any of Patterns are cached for TTL. Up to MaxEntries least recently used results with at most
+	// MaxRows rows each are kept. Zero TTL, MaxEntries and MaxRows are replaced by 1 minute, 1000 and 1000
+	// respectively
+	ResultCacheConfig struct {
+		TTL        time.Duration
+		MaxEntries int
+		MaxRows    int
+		Patterns   []*regexp.Regexp
+	}
+
+	// ResultCache is a read-through cache of Query results keyed by query and arguments. Set it to
+	// Config.ResultCache to apply it to connector. On miss rows of the first result set are read into memory, so
+	// it is intended for small idempotent lookups of reference data. Reading stops after MaxRows rows, the rest of
+	// larger result is streamed and it is not cached. Rows of cached queries implement only [driver.Rows]. If
+	// Config.Session is set, settings required by context are part of the key. Queries in transactions are never
+	// cached. The same cache can be shared by several connectors. It is safe for
+	// concurrent use
+	ResultCache struct {
+		cfg ResultCacheConfig
+
+		mu      sync.Mutex
+		entries map[string]*list.Element
+		lru     *list.List
+	}
+
+	// ResultCacheLogger can be optionally implemented by Logger to log lookups in ResultCache. Query event is not
+	// logged on hit since the database is not queried
+	ResultCacheLogger interface {
+		ResultCacheLookup(ctx context.Context, query string, args []driver.NamedValue, hit bool)
+	}
+
+	resultCacheEntry struct {
+		key       string
+		query     string
+		args      []driver.NamedValue
+		columns   []string
+		rows      [][]driver.Value
+		expiresAt time.Time
+	}
+
+	cachedRows struct {
+		columns []string
+		rows    [][]driver.Value
+		next    int
+	}
+
+	// bufferedRows yields rows buffered by ResultCache before reading the rest of rows
+	bufferedRows struct {
+		driver.Rows
+		buffered [][]driver.Value
+		next     int
+	}
+)
+
+// NewResultCache returns new empty ResultCache
+func NewResultCache(cfg ResultCacheConfig) *ResultCache {
+	if cfg.TTL <= 0 {
+		cfg.TTL = time.Minute
+	}
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = 1000
+	}
+	if cfg.MaxRows <= 0 {
+		cfg.MaxRows = 1000
+	}
+
+	return &ResultCache{
+		cfg:     cfg,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// WithResultCache marks ctx, so results of queries with it are cached by ResultCache for ttl. Zero ttl means
+// ResultCacheConfig.TTL
+func WithResultCache(ctx context.Context, ttl time.Duration) context.Context {
+	return context.WithValue(ctx, ctxKeyResultCache, ttl)
+}
+
+// Invalidate removes cached results of queries for which match returns true and returns number of removed results
+func (c *ResultCache) Invalidate(match func(query string, args []driver.NamedValue) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := 0
+	for key, elem := range c.entries {
+		if entry := elem.Value.(*resultCacheEntry); match(entry.query, entry.args) {
+			c.lru.Remove(elem)
+			delete(c.entries, key)
+			n++
+		}
+	}
+
+	return n
+}
+
+// InvalidateQuery removes cached results of query with any arguments
+func (c *ResultCache) InvalidateQuery(query string) int {
+	return c.Invalidate(func(q string, _ []driver.NamedValue) bool {
+		return q == query
+	})
+}
+
+// Purge removes all cached results
+func (c *ResultCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+// ttl returns TTL of query results, ok is false if query should not be cached. Nil cache does not cache anything
+func (c *ResultCache) ttl(ctx context.Context, query string) (ttl time.Duration, ok bool) {
+	if c == nil {
+		return 0, false
+	}
+
+	if ttl, ok := ctx.Value(ctxKeyResultCache).(time.Duration); ok {
+		return cmp.Or(ttl, c.cfg.TTL), true
+	}
+
+	for _, pattern := range c.cfg.Patterns {
+		if pattern.MatchString(query) {
+			return c.cfg.TTL, true
+		}
+	}
+
+	return 0, false
+}
+
+// get returns rows of cached result and logs lookup
+func (c *ResultCache) get(ctx context.Context, l Logger, key string, query string, args []driver.NamedValue) (
+	driver.Rows, bool) {
+	c.mu.Lock()
+	var rows driver.Rows
+	elem, hit := c.entries[key]
+	if hit {
+		entry := elem.Value.(*resultCacheEntry)
+		if time.Now().Before(entry.expiresAt) {
+			c.lru.MoveToFront(elem)
+			rows = &cachedRows{columns: entry.columns, rows: entry.rows}
+		} else {
+			c.lru.Remove(elem)
+			delete(c.entries, key)
+			hit = false
+		}
+	}
+	c.mu.Unlock()
+
+	if rl, ok := l.(ResultCacheLogger); ok {
+		rl.ResultCacheLookup(ctx, query, args, hit)
+	}
+
+	return rows, hit
+}
+
+// fill reads rows and stores result if it has at most MaxRows rows. Returned rows contain the same result. If result
+// is too large, buffering stops and returned rows yield buffered rows followed by the rest of rows
+func (c *ResultCache) fill(key string, query string, args []driver.NamedValue, ttl time.Duration, rows driver.Rows) (
+	driver.Rows, error) {
+	entry := &resultCacheEntry{
+		key:       key,
+		query:     query,
+		args:      slices.Clone(args),
+		columns:   rows.Columns(),
+		expiresAt: time.Now().Add(ttl),
+	}
+
+	for {
+		dest := make([]driver.Value, len(entry.columns))
+		err := rows.Next(dest)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+
+		for i, v := range dest {
+			if b, ok := v.([]byte); ok {
+				dest[i] = slices.Clone(b)
+			}
+		}
+		entry.rows = append(entry.rows, dest)
+
+		if len(entry.rows) > c.cfg.MaxRows {
+			return &bufferedRows{Rows: rows, buffered: entry.rows}, nil
+		}
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	c.put(entry)
+
+	return &cachedRows{columns: entry.columns, rows: entry.rows}, nil
+}
+
+func (c *ResultCache) put(entry *resultCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[entry.key]; ok {
+		c.lru.Remove(elem)
+	}
+	c.entries[entry.key] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.cfg.MaxEntries {
+		evicted := c.lru.Remove(c.lru.Back()).(*resultCacheEntry)
+		delete(c.entries, evicted.key)
+	}
+}
+
+// resultCacheKey returns key of query with args executed in session with settings. Values are formatted with their
+// types, so values of different types are not mixed up
+func resultCacheKey(query string, args []driver.NamedValue, settings []SessionSetting) string {
+	var b strings.Builder
+	b.WriteString(query)
+	for _, arg := range args {
+		_, _ = fmt.Fprintf(&b, "\x00%d:%s:%T:%#v", arg.Ordinal, arg.Name, arg.Value, arg.Value)
+	}
+	for _, s := range settings {
+		_, _ = fmt.Fprintf(&b, "\x01%q=%q", s.Name, s.Value)
+	}
+	return b.String()
+}
+
+func (r *cachedRows) Columns() []string {
+	return r.columns
+}
+
+func (r *cachedRows) Close() error {
+	return nil
+}
+
+func (r *cachedRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+
+	for i, v := range r.rows[r.next] {
+		if b, ok := v.([]byte); ok {
+			v = slices.Clone(b)
+		}
+		dest[i] = v
+	}
+	r.next++
+
+	return nil
+}
+
+func (r *bufferedRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.buffered) {
+		return r.Rows.Next(dest)
+	}
+
+	copy(dest, r.buffered[r.next])
+	r.buffered[r.next] = nil
+	r.next++
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+func TestResultCacheSessionSettings(t *testing.T) {
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Default:  fakedriver.Response{Columns: []string{"a"}, Rows: [][]driver.Value{{int64(1)}}},
+	})
+
+	l := &countingLogger{events: make(map[string]int)}
+	db := sql.OpenDB(NewConnectorFromConnector(d, Config{
+		LogHandler:  l,
+		Session:     &SessionConfig{},
+		ResultCache: NewResultCache(ResultCacheConfig{}),
+	}))
+	defer db.Close()
+
+	ctx := WithResultCache(context.Background(), 0)
+	tenant1 := WithSessionSetting(ctx, "app.tenant_id", "1")
+	tenant2 := WithSessionSetting(ctx, "app.tenant_id", "2")
+
+	for i, tt := range []struct {
+		ctx     context.Context
+		queries int
+	}{
+		{tenant1, 1},
+		{tenant1, 0},
+		{tenant2, 1},
+		{ctx, 1},
+	} {
+		var a int
+		if err := db.QueryRowContext(tt.ctx, "SELECT a FROM t").Scan(&a); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if events := l.take(); events["Query"] != tt.queries {
+			t.Errorf("query %d: expected %d queries to the database, got %d", i, tt.queries, events["Query"])
+		}
+	}
+}
+
+func TestResultCacheMaxRows(t *testing.T) {
+	errBroken := errors.New("broken")
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Default: fakedriver.Response{
+			Columns: []string{"a"},
+			Rows:    [][]driver.Value{{int64(1)}, {int64(2)}, {int64(3)}, {int64(4)}},
+			NextErr: errBroken,
+		},
+	})
+
+	l := &countingLogger{events: make(map[string]int)}
+	db := sql.OpenDB(NewConnectorFromConnector(d, Config{
+		LogHandler:  l,
+		ResultCache: NewResultCache(ResultCacheConfig{MaxRows: 2}),
+	}))
+	defer db.Close()
+
+	ctx := WithResultCache(context.Background(), 0)
+	for range 2 {
+		rows, err := db.QueryContext(ctx, "SELECT a FROM t")
+		if err != nil {
+			t.Fatalf("expected rows beyond MaxRows not to be buffered, got %v", err)
+		}
+
+		var got []int
+		for rows.Next() {
+			var a int
+			if err = rows.Scan(&a); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got = append(got, a)
+		}
+		if len(got) != 4 || got[0] != 1 || got[3] != 4 || !errors.Is(rows.Err(), errBroken) {
+			t.Errorf("expected all rows followed by error, got %v, %v", got, rows.Err())
+		}
+		_ = rows.Close()
+	}
+
+	if events := l.take(); events["Query"] != 2 {
+		t.Errorf("expected large result not to be cached, got %d queries to the database", events["Query"])
+	}
+}
@@ -5,11 +5,18 @@ import (
 	"database/sql/driver"
 )
 
+var (
+	_ driver.Result = (*queryResult)(nil)
+)
+
 type (
-	queryResult struct {
-		logHandler       Logger
-		queryErrReplacer QueryErrReplacer
+	// ExecResultLogger can be optionally implemented by Logger to log number of rows affected by successful Exec
+	// calls. Rows affected are requested from the driver right after Exec event is logged
+	ExecResultLogger interface {
+		ExecResult(ctx context.Context, query string, args []driver.NamedValue, rowsAffected int64, err error)
+	}
 
+	queryResult struct {
 		ctx    context.Context
 		result driver.Result
 	}
@@ -22,3 +29,14 @@ func (r *queryResult) LastInsertId() (int64, error) {
 func (r *queryResult) RowsAffected() (int64, error) {
 	return r.result.RowsAffected()
 }
+
+// logExecResult passes rows affected by result to ExecResultLogger if l implements it
+func logExecResult(ctx context.Context, l Logger, query string, args []driver.NamedValue, result driver.Result) {
+	erl, ok := l.(ExecResultLogger)
+	if !ok {
+		return
+	}
+
+	n, err := result.RowsAffected()
+	erl.ExecResult(ctx, query, args, n, err)
+}
@@ -0,0 +1,176 @@
+package logsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"syscall"
+	"time"
+)
+
+const (
+	// RetryNever means that error is not transient
+	RetryNever RetryAction = iota
+	// RetrySameConn means that call can be retried on the same connection after backoff
+	RetrySameConn
+	// RetryNewConn means that connection is broken. [driver.ErrBadConn] is returned, so [database/sql] retries the
+	// call on another connection
+	RetryNewConn
+)
+
+var (
+	// DefaultRetrySQLStates are SQLSTATE codes of serialization failure and deadlock
+	DefaultRetrySQLStates = []string{"40001", "40P01"}
+)
+
+type (
+	// RetryAction defines how failed call should be retried
+	RetryAction uint8
+
+	// RetryClassifier decides whether failed call can be retried. Use it to recognize error types of concrete driver.
+	// Errors of canceled or expired contexts are never retried regardless of classifier
+	RetryClassifier func(err error) RetryAction
+
+	// RetryPolicy describes retries of failed Exec and Query calls of connections and prepared statements outside
+	// transactions.
+	// Call is performed at most MaxAttempts times. Backoff before the n-th retry is InitialBackoff*Multiplier^(n-1)
+	// limited by MaxBackoff, randomly changed by up to Jitter fraction of it. Call is not retried if backoff exceeds
+	// context deadline. If Classifier is nil, NewSQLStateRetryClassifier(SQLStates...) is used, and if SQLStates is
+	// empty, DefaultRetrySQLStates are used.
+	//
+	// Exec calls failed with RetryNewConn errors are retried only if RetryExecNewConn is true, since the connection
+	// may have broken after the database has applied the statement, so retried Exec would be applied twice
+	RetryPolicy struct {
+		MaxAttempts      int
+		InitialBackoff   time.Duration
+		MaxBackoff       time.Duration
+		Multiplier       float64
+		Jitter           float64
+		SQLStates        []string
+		Classifier       RetryClassifier
+		RetryExecNewConn bool
+	}
+
+	// RetryLogger can be optionally implemented by Logger to log retries
+	RetryLogger interface {
+		// Retry is called before the next attempt of query. attempt is a number of failed attempt starting from 1,
+		// err is its error. backoff is zero if call is going to be retried by [database/sql] on another connection
+		Retry(ctx context.Context, query string, attempt int, err error, backoff time.Duration)
+	}
+)
+
+// NewSQLStateRetryClassifier returns RetryClassifier that retries on the same connection errors which implement
+// interface{ SQLState() string } with one of sqlStates, and on another connection errors of broken network
+// connections (ECONNRESET, EPIPE, unexpected EOF)
+func NewSQLStateRetryClassifier(sqlStates ...string) RetryClassifier {
+	return func(err error) RetryAction {
+		if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return RetryNewConn
+		}
+
+		var sqlStateErr interface{ SQLState() string }
+		if errors.As(err, &sqlStateErr) && slices.Contains(sqlStates, sqlStateErr.SQLState()) {
+			return RetrySameConn
+		}
+
+		return RetryNever
+	}
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 3
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = 50 * time.Millisecond
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = time.Second
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = 2
+	}
+	if p.Classifier == nil {
+		sqlStates := p.SQLStates
+		if len(sqlStates) == 0 {
+			sqlStates = DefaultRetrySQLStates
+		}
+		p.Classifier = NewSQLStateRetryClassifier(sqlStates...)
+	}
+
+	return p
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := float64(p.InitialBackoff)
+	for range attempt - 1 {
+		backoff *= p.Multiplier
+		if backoff >= float64(p.MaxBackoff) {
+			break
+		}
+	}
+	backoff = min(backoff, float64(p.MaxBackoff))
+
+	if p.Jitter > 0 {
+		backoff += backoff * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(backoff)
+}
+
+func (p RetryPolicy) classify(err error) RetryAction {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, driver.ErrSkip) ||
+		errors.Is(err, driver.ErrBadConn) {
+		return RetryNever
+	}
+
+	return p.Classifier(err)
+}
+
+// withRetry performs call of op and retries it according to retry policy of the connection. Calls inside
+// transactions are never retried
+func withRetry[T any](ctx context.Context, c *connection, op Op, query string, call func() (T, error)) (T, error) {
+	v, err := call()
+	if c.retryPolicy == nil || c.inTx {
+		return v, err
+	}
+
+	for attempt := 1; err != nil; attempt++ {
+		action := c.retryPolicy.classify(err)
+		if action == RetryNever || action == RetryNewConn && op == OpExec && !c.retryPolicy.RetryExecNewConn {
+			break
+		}
+
+		if action == RetryNewConn {
+			if rl, ok := c.logHandler.(RetryLogger); ok {
+				rl.Retry(ctx, query, attempt, err, 0)
+			}
+			return v, fmt.Errorf("%w: %w", driver.ErrBadConn, err)
+		}
+
+		if attempt >= c.retryPolicy.MaxAttempts {
+			break
+		}
+
+		backoff := c.retryPolicy.backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+			break
+		}
+
+		if rl, ok := c.logHandler.(RetryLogger); ok {
+			rl.Retry(ctx, query, attempt, err, backoff)
+		}
+
+		if !sleep(ctx, backoff) {
+			break
+		}
+
+		v, err = call()
+	}
+
+	return v, err
+}
@@ -0,0 +1,194 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+type (
+	// retryLogger records backoffs of retries
+	retryLogger struct {
+		*countingLogger
+
+		mu       sync.Mutex
+		backoffs []time.Duration
+	}
+)
+
+func (l *retryLogger) Retry(_ context.Context, _ string, _ int, _ error, backoff time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.backoffs = append(l.backoffs, backoff)
+}
+
+// openRetryConn opens logged connection which calls fail with err times times, calls counts all calls
+func openRetryConn(t *testing.T, policy RetryPolicy, err error, times int, calls *atomic.Int32) (*connection,
+	*retryLogger) {
+	t.Helper()
+
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{
+			{
+				Match: func(string, []driver.NamedValue) bool {
+					return calls.Add(1) <= int32(times)
+				},
+				Response: fakedriver.Response{Err: err},
+			},
+		},
+	})
+
+	l := &retryLogger{countingLogger: &countingLogger{events: make(map[string]int)}}
+	conn, connErr := NewConnectorFromConnector(d, Config{LogHandler: l, Retry: &policy}).Connect(context.Background())
+	if connErr != nil {
+		t.Fatalf("unexpected error: %v", connErr)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn.(*connection), l
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}.withDefaults()
+
+	var backoffs []time.Duration
+	for attempt := 1; attempt <= 5; attempt++ {
+		backoffs = append(backoffs, p.backoff(attempt))
+	}
+	expected := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond,
+		50 * time.Millisecond, 50 * time.Millisecond}
+	if !slices.Equal(backoffs, expected) {
+		t.Errorf("expected backoffs %v, got %v", expected, backoffs)
+	}
+
+	p.Jitter = 0.5
+	for range 100 {
+		if backoff := p.backoff(1); backoff < 5*time.Millisecond || backoff > 15*time.Millisecond {
+			t.Fatalf("expected backoff within jitter, got %s", backoff)
+		}
+	}
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		times    int
+		failed   bool
+		calls    int32
+		backoffs []time.Duration
+	}{
+		{
+			name: "recovered", err: sqlStateError("40001"), times: 2, calls: 3,
+			backoffs: []time.Duration{time.Millisecond, 2 * time.Millisecond},
+		},
+		{
+			name: "exhausted", err: sqlStateError("40001"), times: 5, failed: true, calls: 3,
+			backoffs: []time.Duration{time.Millisecond, 2 * time.Millisecond},
+		},
+		{name: "not transient", err: sqlStateError("23505"), times: 5, failed: true, calls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			conn, l := openRetryConn(t, RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}, tt.err,
+				tt.times, &calls)
+
+			_, err := conn.ExecContext(context.Background(), "UPDATE t SET a = 1", nil)
+			if failed := errors.Is(err, tt.err); failed != tt.failed {
+				t.Errorf("expected failure %t, got %v", tt.failed, err)
+			}
+			if calls.Load() != tt.calls {
+				t.Errorf("expected %d calls, got %d", tt.calls, calls.Load())
+			}
+			if !slices.Equal(l.backoffs, tt.backoffs) {
+				t.Errorf("expected backoffs %v, got %v", tt.backoffs, l.backoffs)
+			}
+		})
+	}
+}
+
+func TestRetryInTx(t *testing.T) {
+	var calls atomic.Int32
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{{
+			Query: "UPDATE t SET a = 1",
+			Match: func(string, []driver.NamedValue) bool {
+				calls.Add(1)
+				return true
+			},
+			Response: fakedriver.Response{Err: sqlStateError("40001")},
+		}},
+	})
+
+	db := sql.OpenDB(NewConnectorFromConnector(d, Config{
+		LogHandler: &countingLogger{events: make(map[string]int)},
+		Retry:      &RetryPolicy{InitialBackoff: time.Millisecond},
+	}))
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec("UPDATE t SET a = 1"); !errors.Is(err, sqlStateError("40001")) {
+		t.Errorf("expected error, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected statement in transaction not to be retried, got %d calls", calls.Load())
+	}
+}
+
+func TestRetryNewConn(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		query    bool
+		badConn  bool
+		backoffs []time.Duration
+	}{
+		{name: "query", query: true, badConn: true, backoffs: []time.Duration{0}},
+		{name: "exec", query: false, badConn: false},
+		{name: "exec opted in", policy: RetryPolicy{RetryExecNewConn: true}, badConn: true,
+			backoffs: []time.Duration{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			conn, l := openRetryConn(t, tt.policy, syscall.ECONNRESET, 1, &calls)
+
+			var err error
+			if tt.query {
+				_, err = conn.QueryContext(context.Background(), "SELECT 1", nil)
+			} else {
+				_, err = conn.ExecContext(context.Background(), "UPDATE t SET a = 1", nil)
+			}
+
+			if !errors.Is(err, syscall.ECONNRESET) || errors.Is(err, driver.ErrBadConn) != tt.badConn {
+				t.Errorf("expected bad connection %t, got %v", tt.badConn, err)
+			}
+			if calls.Load() != 1 {
+				t.Errorf("expected call not to be retried on the same connection, got %d calls", calls.Load())
+			}
+			if !slices.Equal(l.backoffs, tt.backoffs) {
+				t.Errorf("expected backoffs %v, got %v", tt.backoffs, l.backoffs)
+			}
+		})
+	}
+}
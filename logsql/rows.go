@@ -8,12 +8,32 @@ import (
 	"time"
 )
 
+var (
+	_ driver.Rows                           = (*queryRows)(nil)
+	_ driver.RowsNextResultSet              = (*queryRows)(nil)
+	_ driver.RowsColumnTypeScanType         = (*queryRows)(nil)
+	_ driver.RowsColumnTypeDatabaseTypeName = (*queryRows)(nil)
+	_ driver.RowsColumnTypeLength           = (*queryRows)(nil)
+	_ driver.RowsColumnTypeNullable         = (*queryRows)(nil)
+	_ driver.RowsColumnTypePrecisionScale   = (*queryRows)(nil)
+)
+
 type (
 	queryRows struct {
 		logHandler Logger
 
 		connCtx context.Context
+		cancel  context.CancelFunc
 		rows    driver.Rows
+		// onClose is called after rows are closed, if it is not nil
+		onClose func()
+
+		// query, tctx and timeout are used to report default Query timeout during iteration, timeout is zero if it
+		// was not applied
+		query    string
+		tctx     context.Context
+		timeout  time.Duration
+		timedOut bool
 	}
 )
 
@@ -24,8 +44,14 @@ func (r *queryRows) Columns() []string {
 func (r *queryRows) Close() error {
 	t0 := time.Now()
 
-	err := r.rows.Close()
+	err := r.checkTimeout(r.rows.Close())
+	if r.cancel != nil {
+		r.cancel()
+	}
 	r.logHandler.RowsClose(r.connCtx, err, time.Since(t0))
+	if r.onClose != nil {
+		r.onClose()
+	}
 
 	return err
 }
@@ -33,7 +59,7 @@ func (r *queryRows) Close() error {
 func (r *queryRows) Next(dest []driver.Value) error {
 	t0 := time.Now()
 
-	err := r.rows.Next(dest)
+	err := r.checkTimeout(r.rows.Next(dest))
 	r.logHandler.RowsNext(r.connCtx, dest, err, time.Since(t0))
 
 	return err
@@ -54,7 +80,14 @@ func (r *queryRows) NextResultSet() error {
 		return io.EOF
 	}
 
-	return rs.NextResultSet()
+	t0 := time.Now()
+
+	err := r.checkTimeout(rs.NextResultSet())
+	if rl, ok := r.logHandler.(ResultSetLogger); ok {
+		rl.RowsNextResultSet(r.connCtx, err, time.Since(t0))
+	}
+
+	return err
 }
 
 func (r *queryRows) ColumnTypeScanType(index int) reflect.Type {
@@ -101,3 +134,16 @@ func (r *queryRows) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
 
 	return rs.ColumnTypePrecisionScale(index)
 }
+
+// checkTimeout returns *TimeoutError if err of iteration was caused by default Query timeout, see Timeouts. Timeout
+// is reported once, the following errors are returned as is
+func (r *queryRows) checkTimeout(err error) error {
+	if err == io.EOF || r.timedOut {
+		return err
+	}
+
+	err = checkTimeout(r.connCtx, r.tctx, r.logHandler, OpQuery, r.query, r.timeout, err)
+	_, r.timedOut = err.(*TimeoutError)
+
+	return err
+}
@@ -0,0 +1,147 @@
+package logsql
+
+import (
+	"cmp"
+	"context"
+	"database/sql/driver"
+	"slices"
+)
+
+const (
+	// DefaultSessionSetQuery sets PostgreSQL run-time parameter for the session
+	DefaultSessionSetQuery = "SELECT set_config($1, $2, false)"
+)
+
+type (
+	// SessionSetting is a name and value of session variable, e.g. app.tenant_id
+	SessionSetting struct {
+		Name  string
+		Value string
+	}
+
+	// SessionConfig makes session state of connection match settings required by context of each query and
+	// transaction. Set it to Config.Session to apply it to connector.
+	//
+	// Settings returns settings required by ctx, if it is nil, SessionSettingsFromContext is used. SetQuery is
+	// executed with name and value of each changed setting as arguments, settings that are not required anymore are
+	// set to empty value. If SetQuery is empty, DefaultSessionSetQuery is used. SetQuery is executed directly on
+	// the underlying connection, so it is not logged and not subject to budget, limiter, policy, retries and breaker.
+	//
+	// Last applied settings are cached per connection, so queries are executed only when settings change. Cache is
+	// invalidated on session reset, on transaction rollback and on failures
+	SessionConfig struct {
+		Settings func(ctx context.Context) []SessionSetting
+		SetQuery string
+	}
+
+	// sessionState is a cache of settings applied to connection
+	sessionState struct {
+		// applied are the last applied settings sorted by name
+		applied []SessionSetting
+		// valid is false if actual settings of session are unknown, applied are kept to know what should be unset
+		valid bool
+	}
+)
+
+// WithSessionSetting returns ctx with session setting, so connections used with ctx have it applied. The latest
+// value of the same setting overrides the previous one
+func WithSessionSetting(ctx context.Context, name string, value string) context.Context {
+	settings := slices.Clone(SessionSettingsFromContext(ctx))
+
+	i, found := slices.BinarySearchFunc(settings, name, func(s SessionSetting, name string) int {
+		return cmp.Compare(s.Name, name)
+	})
+	if found {
+		settings[i].Value = value
+	} else {
+		settings = slices.Insert(settings, i, SessionSetting{Name: name, Value: value})
+	}
+
+	return context.WithValue(ctx, ctxKeySessionSettings, settings)
+}
+
+// SessionSettingsFromContext returns settings added to ctx by WithSessionSetting sorted by name
+func SessionSettingsFromContext(ctx context.Context) []SessionSetting {
+	settings, _ := ctx.Value(ctxKeySessionSettings).([]SessionSetting)
+	return settings
+}
+
+func (c SessionConfig) withDefaults() SessionConfig {
+	if c.Settings == nil {
+		c.Settings = SessionSettingsFromContext
+	}
+	if c.SetQuery == "" {
+		c.SetQuery = DefaultSessionSetQuery
+	}
+
+	return c
+}
+
+// ensureSession applies settings required by ctx to connection if they differ from the last applied ones
+func (c *connection) ensureSession(ctx context.Context) error {
+	if c.session == nil {
+		return nil
+	}
+
+	required := c.requiredSettings(ctx)
+	if c.sessionState.valid && slices.Equal(c.sessionState.applied, required) {
+		return nil
+	}
+
+	var changed []SessionSetting
+	for _, s := range c.sessionState.applied {
+		if !slices.ContainsFunc(required, func(r SessionSetting) bool { return r.Name == s.Name }) {
+			changed = append(changed, SessionSetting{Name: s.Name})
+		}
+	}
+	for _, s := range required {
+		if !c.sessionState.valid || !slices.Contains(c.sessionState.applied, s) {
+			changed = append(changed, s)
+		}
+	}
+
+	for _, s := range changed {
+		err := execConn(ctx, c.conn, c.session.SetQuery, []driver.NamedValue{
+			{Ordinal: 1, Value: s.Name},
+			{Ordinal: 2, Value: s.Value},
+		})
+		if err != nil {
+			c.sessionState = sessionState{applied: mergeSettings(c.sessionState.applied, required)}
+			return err
+		}
+	}
+
+	c.sessionState = sessionState{applied: slices.Clone(required), valid: true}
+
+	return nil
+}
+
+// requiredSettings returns settings required by ctx sorted by name, nil if Config.Session is not set
+func (c *connection) requiredSettings(ctx context.Context) []SessionSetting {
+	if c.session == nil {
+		return nil
+	}
+
+	required := c.session.Settings(ctx)
+	if !slices.IsSortedFunc(required, compareSettings) {
+		required = slices.SortedFunc(slices.Values(required), compareSettings)
+	}
+
+	return required
+}
+
+// resetSessionState invalidates cache of applied settings
+func (c *connection) resetSessionState() {
+	c.sessionState.valid = false
+}
+
+// mergeSettings returns settings with names from both a and b, so all of them are unset or applied next time
+func mergeSettings(a []SessionSetting, b []SessionSetting) []SessionSetting {
+	merged := slices.Concat(a, b)
+	slices.SortFunc(merged, compareSettings)
+	return slices.CompactFunc(merged, func(x SessionSetting, y SessionSetting) bool { return x.Name == y.Name })
+}
+
+func compareSettings(a SessionSetting, b SessionSetting) int {
+	return cmp.Compare(a.Name, b.Name)
+}
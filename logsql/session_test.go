@@ -0,0 +1,72 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+func TestSessionSettings(t *testing.T) {
+	var sets atomic.Int32
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{{
+			Query: DefaultSessionSetQuery,
+			Match: func(string, []driver.NamedValue) bool {
+				sets.Add(1)
+				return true
+			},
+		}},
+	})
+
+	l := &countingLogger{events: make(map[string]int)}
+	db := sql.OpenDB(NewConnectorFromConnector(d, Config{
+		LogHandler: l,
+		Session:    &SessionConfig{},
+		Budget:     BudgetConfig{Enforce: true},
+	}))
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	ctx := WithBudget(WithSessionSetting(context.Background(), "app.tenant_id", "1"), 1, 0)
+
+	if _, err := db.ExecContext(ctx, "UPDATE t SET a = 1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sets.Load() != 1 {
+		t.Errorf("expected 1 set query, got %d", sets.Load())
+	}
+	if events := l.take(); events["Exec"] != 1 {
+		t.Errorf("expected set query not to be logged, got %d Exec events", events["Exec"])
+	}
+
+	// Connection is not returned to pool between rollback and next query, so it is not reset
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer conn.Close()
+
+	tx, err := conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	txCtx := WithSessionSetting(context.Background(), "app.tenant_id", "2")
+	if _, err = tx.ExecContext(txCtx, "UPDATE t SET a = 2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = tx.Rollback(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err = conn.ExecContext(txCtx, "UPDATE t SET a = 3"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sets.Load() != 4 {
+		t.Errorf("expected settings to be applied again after rollback, got %d set queries", sets.Load())
+	}
+}
@@ -0,0 +1,45 @@
+// Package sink provides [logsql.Logger] that writes every event to [io.Writer] as JSON Lines or logfmt, so logsql
+// can be used without a structured logging library:
+//
+//	f, err := sink.NewRotatingFile("sql.log", 100<<20, 5)
+//	db := sql.OpenDB(logsql.NewConnectorFromConnector(connector, logsql.Config{
+//		LogHandler: sink.New(f, sink.Config{
+//			Format: sink.FormatJSON,
+//			Events: sink.EventExec | sink.EventQuery | sink.EventTxCommit | sink.EventTxRollback,
+//		}),
+//	}))
+//
+// Every line contains fields in the following order, fields that are not provided by the event are omitted:
+//   - time: RFC 3339 time of the event
+//   - event: name of the event, see Event
+//   - duration_ms: duration of the call in milliseconds
+//   - tx_id: ID of transaction, see [logsql.TxIDFromContext]
+//   - query: text of the query
+//   - args: arguments of the query, in logfmt every argument is written as argN field where N is its ordinal
+//   - values: row returned by RowsNext, in logfmt every value is written as valueN field
+//   - rows_affected: rows affected by Exec
+//   - err: error returned by the call
+//   - err_class: class of the error, see [logsql.ErrClass]
+//   - replaced_err: error returned to the caller if it was replaced by [logsql.QueryErrReplacer]
+//
+// Events of optional interfaces of [logsql.Logger] write their own fields after tx_id and before err:
+//   - retry: query, attempt, backoff_ms; tx_retry: attempt, backoff_ms
+//   - savepoint, release_savepoint, rollback_to_savepoint: savepoint (name of savepoint)
+//   - breaker_state_change: from, to (states of breaker)
+//   - timeout: op, query, timeout_ms
+//   - limiter_wait: query, class (limit class, omitted for rate limit), duration_ms is time of waiting
+//   - policy_violation: query, violation, report_only
+//   - replica_health: target, healthy
+//   - host_down: host, backoff_ms; failover: from, to (hosts)
+//   - stmt_cache_lookup: query, hit, hits, misses, evictions, len
+//   - result_cache_lookup: query, args, hit
+//   - budget_exceeded: query, queries, max_queries, total_ms, max_total_ms
+//   - n_plus_one: fingerprint, count, callers (in logfmt every caller is written as callerN field)
+//   - slow_query: query, args, plan (omitted if plan was not captured), duration_ms is duration of the query
+//
+// Values are encoded as JSON null, booleans, numbers and strings. []byte is written as string if it is valid UTF-8
+// and as hexadecimal string with 0x prefix otherwise, time.Time is written in RFC 3339 format. In logfmt strings
+// from arguments and values are always quoted, so they can be distinguished from null, booleans and numbers.
+//
+// JSON Lines can be analyzed by cmd/sqllog command of the module.
+package sink
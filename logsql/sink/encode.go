@@ -0,0 +1,146 @@
+package sink
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	FormatJSON Format = iota
+	FormatLogfmt
+)
+
+type (
+	// Format of written lines
+	Format uint8
+
+	// field is a single key and value of a line. Values are nil, bool, int64, float64, string or []any of them
+	field struct {
+		key   string
+		value any
+	}
+)
+
+// encodeValue converts driver value to one of field value types
+func encodeValue(v driver.Value) any {
+	switch v := v.(type) {
+	case nil, bool, int64, string:
+		return v
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return strconv.FormatFloat(v, 'g', -1, 64)
+		}
+		return v
+	case []byte:
+		if utf8.Valid(v) {
+			return string(v)
+		}
+		return "0x" + hex.EncodeToString(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func encodeNamedValues(args []driver.NamedValue) []any {
+	values := make([]any, len(args))
+	for i, arg := range args {
+		values[i] = encodeValue(arg.Value)
+	}
+
+	return values
+}
+
+func encodeValues(dest []driver.Value) []any {
+	values := make([]any, len(dest))
+	for i, v := range dest {
+		values[i] = encodeValue(v)
+	}
+
+	return values
+}
+
+// appendJSON appends fields as JSON object terminated by new line
+func appendJSON(buf *bytes.Buffer, fields []field) {
+	buf.WriteByte('{')
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		appendJSONValue(buf, f.key)
+		buf.WriteByte(':')
+		appendJSONValue(buf, f.value)
+	}
+	buf.WriteString("}\n")
+}
+
+// appendJSONValue appends value without escaping of HTML characters, which are common in queries
+func appendJSONValue(buf *bytes.Buffer, value any) {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+
+	err := enc.Encode(value)
+	if err != nil {
+		_ = enc.Encode(err.Error())
+	}
+	buf.Truncate(buf.Len() - 1)
+}
+
+// appendLogfmt appends fields as logfmt line. Lists are flattened into fields named by singular form of the key and
+// ordinal of the element, e.g. args into arg1, arg2
+func appendLogfmt(buf *bytes.Buffer, fields []field) {
+	first := true
+	write := func(key, value string) {
+		if !first {
+			buf.WriteByte(' ')
+		}
+		first = false
+		buf.WriteString(key)
+		buf.WriteByte('=')
+		buf.WriteString(value)
+	}
+
+	for _, f := range fields {
+		list, ok := f.value.([]any)
+		if !ok {
+			write(f.key, logfmtValue(f.value, false))
+			continue
+		}
+		for i, v := range list {
+			write(strings.TrimSuffix(f.key, "s")+strconv.Itoa(i+1), logfmtValue(v, true))
+		}
+	}
+	buf.WriteByte('\n')
+}
+
+// logfmtValue formats value, strings are quoted if they require it or if alwaysQuote is true
+func logfmtValue(v any, alwaysQuote bool) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		if alwaysQuote || v == "" || strings.ContainsFunc(v, func(r rune) bool {
+			return r <= ' ' || r == '=' || r == '"' || r == utf8.RuneError || r == 0x7f
+		}) {
+			return strconv.Quote(v)
+		}
+		return v
+	default:
+		return strconv.Quote(fmt.Sprint(v))
+	}
+}
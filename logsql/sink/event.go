@@ -0,0 +1,101 @@
+package sink
+
+import (
+	"math/bits"
+)
+
+const (
+	EventConnect Event = 1 << iota
+	EventConnClose
+	EventTxBegin
+	EventTxCommit
+	EventTxRollback
+	EventExec
+	EventQuery
+	EventPing
+	EventRowsClose
+	EventRowsNext
+	EventPrepareStatement
+	EventClosePreparedStatement
+	EventExecPreparedStatement
+	EventQueryPreparedStatement
+	EventResetSession
+	EventConnInvalidated
+	EventCheckNamedValue
+	EventRowsNextResultSet
+	EventExecResult
+	EventRetry
+	EventTxRetry
+	EventSavepoint
+	EventReleaseSavepoint
+	EventRollbackToSavepoint
+	EventBreakerStateChange
+	EventTimeout
+	EventLimiterWait
+	EventPolicyViolation
+	EventReplicaHealth
+	EventHostDown
+	EventFailover
+	EventStmtCacheLookup
+	EventResultCacheLookup
+	EventBudgetExceeded
+	EventNPlusOne
+	EventSlowQuery
+
+	// EventAll selects every event
+	EventAll Event = 1<<iota - 1
+)
+
+type (
+	// Event is a bitmask of events. Every event corresponds to a method of [logsql.Logger] or one of its optional
+	// interfaces
+	Event uint64
+)
+
+var eventNames = [...]string{
+	"connect",
+	"conn_close",
+	"tx_begin",
+	"tx_commit",
+	"tx_rollback",
+	"exec",
+	"query",
+	"ping",
+	"rows_close",
+	"rows_next",
+	"prepare_statement",
+	"close_prepared_statement",
+	"exec_prepared_statement",
+	"query_prepared_statement",
+	"reset_session",
+	"conn_invalidated",
+	"check_named_value",
+	"rows_next_result_set",
+	"exec_result",
+	"retry",
+	"tx_retry",
+	"savepoint",
+	"release_savepoint",
+	"rollback_to_savepoint",
+	"breaker_state_change",
+	"timeout",
+	"limiter_wait",
+	"policy_violation",
+	"replica_health",
+	"host_down",
+	"failover",
+	"stmt_cache_lookup",
+	"result_cache_lookup",
+	"budget_exceeded",
+	"n_plus_one",
+	"slow_query",
+}
+
+// String returns name of a single event that is written to the event field
+func (e Event) String() string {
+	if bits.OnesCount64(uint64(e)) != 1 || e > EventSlowQuery {
+		return "unknown"
+	}
+
+	return eventNames[bits.TrailingZeros64(uint64(e))]
+}
@@ -0,0 +1,331 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+var (
+	_ logsql.Logger           = (*Logger)(nil)
+	_ logsql.SessionLogger    = (*Logger)(nil)
+	_ logsql.ResultSetLogger  = (*Logger)(nil)
+	_ logsql.ExecResultLogger = (*Logger)(nil)
+
+	_ logsql.RetryLogger       = (*Logger)(nil)
+	_ logsql.TxRunnerLogger    = (*Logger)(nil)
+	_ logsql.BreakerLogger     = (*Logger)(nil)
+	_ logsql.TimeoutLogger     = (*Logger)(nil)
+	_ logsql.LimiterLogger     = (*Logger)(nil)
+	_ logsql.PolicyLogger      = (*Logger)(nil)
+	_ logsql.ReplicaLogger     = (*Logger)(nil)
+	_ logsql.FailoverLogger    = (*Logger)(nil)
+	_ logsql.StmtCacheLogger   = (*Logger)(nil)
+	_ logsql.ResultCacheLogger = (*Logger)(nil)
+	_ logsql.BudgetLogger      = (*Logger)(nil)
+	_ logsql.ExplainLogger     = (*Logger)(nil)
+)
+
+type (
+	// Config of Logger. Only events selected by Events are written, zero Events selects EventAll. OnError is called
+	// if line could not be written
+	Config struct {
+		Format  Format
+		Events  Event
+		OnError func(err error)
+	}
+
+	// Logger is a [logsql.Logger] that writes every event as a single line to [io.Writer]. It is safe for concurrent
+	// use, every line is written by a single Write call
+	Logger struct {
+		cfg Config
+
+		mu  sync.Mutex
+		w   io.Writer
+		buf bytes.Buffer
+	}
+)
+
+// New returns Logger writing to w
+func New(w io.Writer, cfg Config) *Logger {
+	if cfg.Events == 0 {
+		cfg.Events = EventAll
+	}
+
+	return &Logger{
+		cfg: cfg,
+		w:   w,
+	}
+}
+
+func (l *Logger) Connect(ctx context.Context, err error, dt time.Duration) {
+	l.log(ctx, EventConnect, dt, err, nil)
+}
+
+func (l *Logger) ConnClose(ctx context.Context, err error, dt time.Duration) {
+	l.log(ctx, EventConnClose, dt, err, nil)
+}
+
+func (l *Logger) TxBegin(ctx context.Context, err error, dt time.Duration) {
+	l.log(ctx, EventTxBegin, dt, err, nil)
+}
+
+func (l *Logger) TxCommit(ctx context.Context, err error, dt time.Duration) {
+	l.log(ctx, EventTxCommit, dt, err, nil)
+}
+
+func (l *Logger) TxRollback(ctx context.Context, err error, dt time.Duration) {
+	l.log(ctx, EventTxRollback, dt, err, nil)
+}
+
+func (l *Logger) Exec(ctx context.Context, query string, args []driver.NamedValue, replacedErr error, err error,
+	dt time.Duration) {
+	l.logQuery(ctx, EventExec, query, args, replacedErr, err, dt)
+}
+
+func (l *Logger) Query(ctx context.Context, query string, args []driver.NamedValue, replacedErr error, err error,
+	dt time.Duration) {
+	l.logQuery(ctx, EventQuery, query, args, replacedErr, err, dt)
+}
+
+func (l *Logger) Ping(ctx context.Context, err error, dt time.Duration) {
+	l.log(ctx, EventPing, dt, err, nil)
+}
+
+func (l *Logger) RowsClose(ctx context.Context, err error, dt time.Duration) {
+	l.log(ctx, EventRowsClose, dt, err, nil)
+}
+
+func (l *Logger) RowsNext(ctx context.Context, dest []driver.Value, err error, dt time.Duration) {
+	if l.cfg.Events&EventRowsNext == 0 {
+		return
+	}
+
+	var fields []field
+	if err == nil {
+		fields = []field{{"values", encodeValues(dest)}}
+	}
+	l.log(ctx, EventRowsNext, dt, err, nil, fields...)
+}
+
+func (l *Logger) PrepareStatement(ctx context.Context, query string, err error, dt time.Duration) {
+	l.log(ctx, EventPrepareStatement, dt, err, nil, field{"query", query})
+}
+
+func (l *Logger) ClosePreparedStatement(ctx context.Context, query string, err error, dt time.Duration) {
+	l.log(ctx, EventClosePreparedStatement, dt, err, nil, field{"query", query})
+}
+
+func (l *Logger) ExecPreparedStatement(ctx context.Context, query string, args []driver.NamedValue, replacedErr error,
+	err error, dt time.Duration) {
+	l.logQuery(ctx, EventExecPreparedStatement, query, args, replacedErr, err, dt)
+}
+
+func (l *Logger) QueryPreparedStatement(ctx context.Context, query string, args []driver.NamedValue,
+	replacedErr error, err error, dt time.Duration) {
+	l.logQuery(ctx, EventQueryPreparedStatement, query, args, replacedErr, err, dt)
+}
+
+func (l *Logger) ResetSession(ctx context.Context, err error, dt time.Duration) {
+	l.log(ctx, EventResetSession, dt, err, nil)
+}
+
+func (l *Logger) ConnInvalidated(ctx context.Context) {
+	l.log(ctx, EventConnInvalidated, -1, nil, nil)
+}
+
+func (l *Logger) CheckNamedValue(ctx context.Context, value driver.NamedValue, err error) {
+	l.log(ctx, EventCheckNamedValue, -1, err, nil, field{"args", []any{encodeValue(value.Value)}})
+}
+
+func (l *Logger) RowsNextResultSet(ctx context.Context, err error, dt time.Duration) {
+	l.log(ctx, EventRowsNextResultSet, dt, err, nil)
+}
+
+func (l *Logger) ExecResult(ctx context.Context, query string, args []driver.NamedValue, rowsAffected int64,
+	err error) {
+	if l.cfg.Events&EventExecResult == 0 {
+		return
+	}
+
+	fields := queryFields(query, args)
+	if err == nil {
+		fields = append(fields, field{"rows_affected", rowsAffected})
+	}
+	l.log(ctx, EventExecResult, -1, err, nil, fields...)
+}
+
+func (l *Logger) Retry(ctx context.Context, query string, attempt int, err error, backoff time.Duration) {
+	l.log(ctx, EventRetry, -1, err, nil, field{"query", query}, field{"attempt", int64(attempt)},
+		field{"backoff_ms", milliseconds(backoff)})
+}
+
+func (l *Logger) TxRetry(ctx context.Context, attempt int, err error, backoff time.Duration) {
+	l.log(ctx, EventTxRetry, -1, err, nil, field{"attempt", int64(attempt)}, field{"backoff_ms", milliseconds(backoff)})
+}
+
+func (l *Logger) Savepoint(ctx context.Context, name string, err error, dt time.Duration) {
+	l.log(ctx, EventSavepoint, dt, err, nil, field{"savepoint", name})
+}
+
+func (l *Logger) ReleaseSavepoint(ctx context.Context, name string, err error, dt time.Duration) {
+	l.log(ctx, EventReleaseSavepoint, dt, err, nil, field{"savepoint", name})
+}
+
+func (l *Logger) RollbackToSavepoint(ctx context.Context, name string, err error, dt time.Duration) {
+	l.log(ctx, EventRollbackToSavepoint, dt, err, nil, field{"savepoint", name})
+}
+
+func (l *Logger) BreakerStateChange(ctx context.Context, from logsql.BreakerState, to logsql.BreakerState, err error) {
+	l.log(ctx, EventBreakerStateChange, -1, err, nil, field{"from", from.String()}, field{"to", to.String()})
+}
+
+func (l *Logger) Timeout(ctx context.Context, op logsql.Op, query string, timeout time.Duration, err error) {
+	fields := []field{{"op", op.String()}}
+	if query != "" {
+		fields = append(fields, field{"query", query})
+	}
+	fields = append(fields, field{"timeout_ms", milliseconds(timeout)})
+	l.log(ctx, EventTimeout, -1, err, nil, fields...)
+}
+
+func (l *Logger) LimiterWait(ctx context.Context, query string, class string, wait time.Duration, err error) {
+	fields := []field{{"query", query}}
+	if class != "" {
+		fields = append(fields, field{"class", class})
+	}
+	l.log(ctx, EventLimiterWait, wait, err, nil, fields...)
+}
+
+func (l *Logger) PolicyViolation(ctx context.Context, query string, violation logsql.PolicyViolation,
+	reportOnly bool) {
+	l.log(ctx, EventPolicyViolation, -1, nil, nil, field{"query", query}, field{"violation", violation.String()},
+		field{"report_only", reportOnly})
+}
+
+func (l *Logger) ReplicaHealth(ctx context.Context, target string, healthy bool, err error) {
+	l.log(ctx, EventReplicaHealth, -1, err, nil, field{"target", target}, field{"healthy", healthy})
+}
+
+func (l *Logger) HostDown(ctx context.Context, host string, err error, backoff time.Duration) {
+	l.log(ctx, EventHostDown, -1, err, nil, field{"host", host}, field{"backoff_ms", milliseconds(backoff)})
+}
+
+func (l *Logger) Failover(ctx context.Context, from string, to string) {
+	l.log(ctx, EventFailover, -1, nil, nil, field{"from", from}, field{"to", to})
+}
+
+func (l *Logger) StmtCacheLookup(ctx context.Context, query string, hit bool, stats logsql.StmtCacheStats) {
+	l.log(ctx, EventStmtCacheLookup, -1, nil, nil, field{"query", query}, field{"hit", hit},
+		field{"hits", int64(stats.Hits)}, field{"misses", int64(stats.Misses)},
+		field{"evictions", int64(stats.Evictions)}, field{"len", int64(stats.Len)})
+}
+
+func (l *Logger) ResultCacheLookup(ctx context.Context, query string, args []driver.NamedValue, hit bool) {
+	if l.cfg.Events&EventResultCacheLookup == 0 {
+		return
+	}
+
+	l.log(ctx, EventResultCacheLookup, -1, nil, nil, append(queryFields(query, args), field{"hit", hit})...)
+}
+
+func (l *Logger) BudgetExceeded(ctx context.Context, query string, usage logsql.BudgetUsage) {
+	l.log(ctx, EventBudgetExceeded, -1, nil, nil, field{"query", query},
+		field{"queries", int64(usage.Queries)}, field{"max_queries", int64(usage.MaxQueries)},
+		field{"total_ms", milliseconds(usage.Duration)}, field{"max_total_ms", milliseconds(usage.MaxDuration)})
+}
+
+func (l *Logger) NPlusOne(ctx context.Context, fingerprint string, count int, callers []string) {
+	if l.cfg.Events&EventNPlusOne == 0 {
+		return
+	}
+
+	list := make([]any, len(callers))
+	for i, caller := range callers {
+		list[i] = caller
+	}
+	l.log(ctx, EventNPlusOne, -1, nil, nil, field{"fingerprint", fingerprint}, field{"count", int64(count)},
+		field{"callers", list})
+}
+
+func (l *Logger) SlowQuery(ctx context.Context, query string, args []driver.NamedValue, dt time.Duration, plan string,
+	err error) {
+	if l.cfg.Events&EventSlowQuery == 0 {
+		return
+	}
+
+	fields := queryFields(query, args)
+	if plan != "" {
+		fields = append(fields, field{"plan", plan})
+	}
+	l.log(ctx, EventSlowQuery, dt, err, nil, fields...)
+}
+
+func (l *Logger) logQuery(ctx context.Context, event Event, query string, args []driver.NamedValue,
+	replacedErr error, err error, dt time.Duration) {
+	if l.cfg.Events&event == 0 {
+		return
+	}
+
+	l.log(ctx, event, dt, err, replacedErr, queryFields(query, args)...)
+}
+
+// queryFields returns query and args fields, args are omitted if there are none
+func queryFields(query string, args []driver.NamedValue) []field {
+	fields := []field{{"query", query}}
+	if len(args) > 0 {
+		fields = append(fields, field{"args", encodeNamedValues(args)})
+	}
+
+	return fields
+}
+
+// log writes line of event if it is selected. Negative dt is omitted, replacedErr is written only if it differs
+// from err
+func (l *Logger) log(ctx context.Context, event Event, dt time.Duration, err, replacedErr error, fields ...field) {
+	if l.cfg.Events&event == 0 {
+		return
+	}
+
+	line := make([]field, 0, 8+len(fields))
+	line = append(line, field{"time", time.Now().Format(time.RFC3339Nano)}, field{"event", event.String()})
+	if dt >= 0 {
+		line = append(line, field{"duration_ms", milliseconds(dt)})
+	}
+	if txID, ok := logsql.TxIDFromContext(ctx); ok {
+		line = append(line, field{"tx_id", int64(txID)})
+	}
+	line = append(line, fields...)
+	if err != nil {
+		line = append(line, field{"err", err.Error()}, field{"err_class", logsql.ClassifyErr(ctx, err).String()})
+	}
+	if replacedErr != nil && replacedErr != err {
+		line = append(line, field{"replaced_err", replacedErr.Error()})
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.buf.Reset()
+	switch l.cfg.Format {
+	case FormatLogfmt:
+		appendLogfmt(&l.buf, line)
+	default:
+		appendJSON(&l.buf, line)
+	}
+
+	_, err = l.w.Write(l.buf.Bytes())
+	if err != nil && l.cfg.OnError != nil {
+		l.cfg.OnError(err)
+	}
+}
+
+// milliseconds returns dt in milliseconds with microsecond precision
+func milliseconds(dt time.Duration) float64 {
+	return float64(dt.Microseconds()) / 1000
+}
@@ -0,0 +1,176 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/logsql"
+)
+
+var timeField = regexp.MustCompile(`(?m)^(\{"time":"[^"]*",|time=\S+ )`)
+
+// lines returns written lines without time field, which is not deterministic
+func lines(buf *bytes.Buffer) []string {
+	return strings.Split(strings.TrimSuffix(timeField.ReplaceAllString(buf.String(), ""), "\n"), "\n")
+}
+
+func TestEncoding(t *testing.T) {
+	values := []driver.Value{
+		nil,
+		true,
+		int64(-1),
+		1.5,
+		math.NaN(),
+		math.Inf(-1),
+		[]byte("héllo \"world\""),
+		[]byte{0xff, 0x00},
+		time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC),
+		"a b",
+	}
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{
+			format: FormatJSON,
+			want: `"event":"rows_next","duration_ms":1.5,"values":[null,true,-1,1.5,"NaN","-Inf",` +
+				`"héllo \"world\"","0xff00","2026-01-02T03:04:05.000000006Z","a b"]}`,
+		},
+		{
+			format: FormatLogfmt,
+			want: `event=rows_next duration_ms=1.5 value1=null value2=true value3=-1 value4=1.5 value5="NaN" ` +
+				`value6="-Inf" value7="héllo \"world\"" value8="0xff00" value9="2026-01-02T03:04:05.000000006Z" ` +
+				`value10="a b"`,
+		},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		l := New(&buf, Config{Format: tt.format})
+		l.RowsNext(context.Background(), values, nil, 1500*time.Microsecond)
+
+		if got := lines(&buf); len(got) != 1 || got[0] != tt.want {
+			t.Errorf("format %d: expected\n%s\ngot\n%s", tt.format, tt.want, strings.Join(got, "\n"))
+		}
+	}
+}
+
+func TestEvents(t *testing.T) {
+	ctx := context.Background()
+	args := []driver.NamedValue{{Ordinal: 1, Value: int64(1)}}
+
+	tests := []struct {
+		name string
+		log  func(l *Logger)
+		want string
+	}{
+		{
+			name: "exec",
+			log: func(l *Logger) {
+				l.Exec(ctx, "UPDATE t SET a = 1 WHERE id = $1", args, errors.New("replaced"), driver.ErrBadConn,
+					time.Millisecond)
+			},
+			want: `"event":"exec","duration_ms":1,"query":"UPDATE t SET a = 1 WHERE id = $1","args":[1],` +
+				`"err":"driver: bad connection","err_class":"bad_conn","replaced_err":"replaced"}`,
+		},
+		{
+			name: "retry",
+			log: func(l *Logger) {
+				l.Retry(ctx, "SELECT 1", 2, driver.ErrBadConn, 50*time.Millisecond)
+			},
+			want: `"event":"retry","query":"SELECT 1","attempt":2,"backoff_ms":50,` +
+				`"err":"driver: bad connection","err_class":"bad_conn"}`,
+		},
+		{
+			name: "breaker_state_change",
+			log: func(l *Logger) {
+				l.BreakerStateChange(ctx, logsql.BreakerClosed, logsql.BreakerOpen, driver.ErrBadConn)
+			},
+			want: `"event":"breaker_state_change","from":"closed","to":"open","err":"driver: bad connection",` +
+				`"err_class":"bad_conn"}`,
+		},
+		{
+			name: "limiter_wait",
+			log: func(l *Logger) {
+				l.LimiterWait(ctx, "SELECT 1", "reports", 2*time.Millisecond, nil)
+			},
+			want: `"event":"limiter_wait","duration_ms":2,"query":"SELECT 1","class":"reports"}`,
+		},
+		{
+			name: "budget_exceeded",
+			log: func(l *Logger) {
+				l.BudgetExceeded(ctx, "SELECT 1", logsql.BudgetUsage{Queries: 10, MaxQueries: 10})
+			},
+			want: `"event":"budget_exceeded","query":"SELECT 1","queries":10,"max_queries":10,"total_ms":0,` +
+				`"max_total_ms":0}`,
+		},
+		{
+			name: "n_plus_one",
+			log: func(l *Logger) {
+				l.NPlusOne(ctx, "select * from t where id = ?", 11, []string{"main.load a.go:1"})
+			},
+			want: `"event":"n_plus_one","fingerprint":"select * from t where id = ?","count":11,` +
+				`"callers":["main.load a.go:1"]}`,
+		},
+		{
+			name: "slow_query",
+			log: func(l *Logger) {
+				l.SlowQuery(ctx, "SELECT * FROM t WHERE id = $1", args, time.Second, "Seq Scan on t", nil)
+			},
+			want: `"event":"slow_query","duration_ms":1000,"query":"SELECT * FROM t WHERE id = $1","args":[1],` +
+				`"plan":"Seq Scan on t"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tt.log(New(&buf, Config{}))
+
+			if got := lines(&buf); len(got) != 1 || got[0] != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, strings.Join(got, "\n"))
+			}
+		})
+	}
+}
+
+func TestEventMask(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, Config{Events: EventExec | EventNPlusOne})
+
+	ctx := context.Background()
+	l.Exec(ctx, "UPDATE t SET a = 1", nil, nil, nil, 0)
+	l.Query(ctx, "SELECT 1", nil, nil, nil, 0)
+	l.RowsNext(ctx, []driver.Value{int64(1)}, nil, 0)
+	l.ExecResult(ctx, "UPDATE t SET a = 1", nil, 1, nil)
+	l.NPlusOne(ctx, "select ?", 11, nil)
+	l.SlowQuery(ctx, "SELECT 1", nil, time.Second, "", nil)
+
+	got := lines(&buf)
+	if len(got) != 2 || !strings.Contains(got[0], `"event":"exec"`) || !strings.Contains(got[1], `"event":"n_plus_one"`) {
+		t.Errorf("expected only exec and n_plus_one events, got\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestEventString(t *testing.T) {
+	for e := EventConnect; e <= EventSlowQuery; e <<= 1 {
+		if e.String() == "unknown" {
+			t.Errorf("event %d has no name", e)
+		}
+	}
+
+	if s := (EventExec | EventQuery).String(); s != "unknown" {
+		t.Errorf("expected mask of several events to be unknown, got %s", s)
+	}
+	if EventAll != EventSlowQuery<<1-1 {
+		t.Errorf("expected EventAll to select every event")
+	}
+}
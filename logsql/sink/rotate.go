@@ -0,0 +1,124 @@
+package sink
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+var (
+	_ io.WriteCloser = (*RotatingFile)(nil)
+)
+
+type (
+	// RotatingFile is a file that is rotated when its size would exceed MaxSize. On rotation path.1 is renamed to
+	// path.2 and so on up to path.MaxBackups, path is renamed to path.1 and new file is created. Every Write is
+	// written to a single file. It is safe for concurrent use
+	RotatingFile struct {
+		path       string
+		maxSize    int64
+		maxBackups int
+
+		mu   sync.Mutex
+		f    *os.File
+		size int64
+	}
+)
+
+// NewRotatingFile opens file at path for appending, it is created if it does not exist. If maxSize is not positive,
+// the file is never rotated. If maxBackups is zero, the file is truncated on rotation
+func NewRotatingFile(path string, maxSize int64, maxBackups int) (*RotatingFile, error) {
+	r := &RotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	err := r.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		err := r.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+
+	return n, err
+}
+
+func (r *RotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.f.Close()
+}
+
+func (r *RotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	r.f, r.size = f, info.Size()
+
+	return nil
+}
+
+// rotate renames files and opens new one. Current file is closed only after new one is opened, so on failure writes
+// continue to the current file
+func (r *RotatingFile) rotate() error {
+	if r.maxBackups == 0 {
+		err := r.f.Truncate(0)
+		if err != nil {
+			return err
+		}
+		r.size = 0
+		return nil
+	}
+
+	for i := r.maxBackups - 1; i > 0; i-- {
+		err := os.Rename(r.backup(i), r.backup(i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	err := os.Rename(r.path, r.backup(1))
+	if err != nil {
+		return err
+	}
+
+	f := r.f
+	err = r.open()
+	if err != nil {
+		// Current file is moved back, so the next rotation starts over
+		return errors.Join(err, os.Rename(r.backup(1), r.path))
+	}
+
+	return f.Close()
+}
+
+func (r *RotatingFile) backup(i int) string {
+	return fmt.Sprintf("%s.%d", r.path, i)
+}
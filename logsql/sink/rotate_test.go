@@ -0,0 +1,61 @@
+package sink
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingFileNoLimit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sql.log")
+	r, err := NewRotatingFile(path, 0, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer r.Close()
+
+	for range 3 {
+		if _, err = r.Write([]byte("line\n")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if _, err = os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Errorf("expected file not to be rotated, got %v", err)
+	}
+}
+
+func TestRotatingFileRenameFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sql.log")
+	r, err := NewRotatingFile(path, 8, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer r.Close()
+
+	if _, err = r.Write([]byte("line 1\n")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// File can not be renamed over non-empty directory
+	if err = os.MkdirAll(filepath.Join(path+".1", "dir"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = r.Write([]byte("line 2\n")); err == nil {
+		t.Fatal("expected rotation error")
+	}
+
+	if err = os.RemoveAll(path + ".1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = r.Write([]byte("line 3\n")); err != nil {
+		t.Fatalf("expected file to be usable after failed rotation, got %v", err)
+	}
+
+	if b, _ := os.ReadFile(path); string(b) != "line 3\n" {
+		t.Errorf("expected new file after rotation, got %q", b)
+	}
+	if b, _ := os.ReadFile(path + ".1"); string(b) != "line 1\n" {
+		t.Errorf("expected rotated file, got %q", b)
+	}
+}
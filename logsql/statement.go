@@ -6,14 +6,24 @@ import (
 	"time"
 )
 
+var (
+	_ driver.Stmt              = (*queryStatement)(nil)
+	_ driver.StmtExecContext   = (*queryStatement)(nil)
+	_ driver.StmtQueryContext  = (*queryStatement)(nil)
+	_ driver.NamedValueChecker = (*queryStatement)(nil)
+	_ driver.ColumnConverter   = (*queryStatement)(nil)
+)
+
 type (
 	queryStatement struct {
-		logHandler       Logger
-		queryErrReplacer QueryErrReplacer
+		logHandler Logger
 
+		conn      *connection
 		connCtx   context.Context
 		query     string
 		statement driver.Stmt
+		// onClose is called after statement is closed, if it is not nil
+		onClose func()
 	}
 )
 
@@ -22,6 +32,9 @@ func (s *queryStatement) Close() error {
 
 	err := s.statement.Close()
 	s.logHandler.ClosePreparedStatement(s.connCtx, s.query, err, time.Since(t0))
+	if s.onClose != nil {
+		s.onClose()
+	}
 
 	return err
 }
@@ -31,14 +44,19 @@ func (s *queryStatement) NumInput() int {
 }
 
 func (s *queryStatement) Exec(args []driver.Value) (driver.Result, error) {
+	ctx := s.conn.txContext(s.connCtx)
+
 	t0 := time.Now()
 
-	result, err := s.statement.Exec(args)
+	result, release, err := invoke(ctx, s.conn, OpExec, s.query, func() (driver.Result, error) {
+		return s.statement.Exec(args)
+	})
+	release()
 	var replacedErr error
 	if err != nil {
-		replacedErr = s.queryErrReplacer(err)
+		replacedErr = s.conn.replaceErr(ctx, err)
 	}
-	s.logHandler.ExecPreparedStatement(s.connCtx, s.query, driverValuesToNamed(args), replacedErr, err, time.Since(t0))
+	s.logHandler.ExecPreparedStatement(ctx, s.query, driverValuesToNamed(args), replacedErr, err, time.Since(t0))
 
 	if err != nil {
 		if replacedErr != nil {
@@ -47,41 +65,74 @@ func (s *queryStatement) Exec(args []driver.Value) (driver.Result, error) {
 		return nil, err
 	}
 
-	return result, nil
+	logExecResult(ctx, s.logHandler, s.query, driverValuesToNamed(args), result)
+
+	return &queryResult{
+		ctx:    ctx,
+		result: result,
+	}, nil
 }
 
 func (s *queryStatement) Query(args []driver.Value) (driver.Rows, error) {
+	ctx := s.conn.txContext(s.connCtx)
+
 	t0 := time.Now()
 
-	rows, err := s.statement.Query(args)
+	rows, release, err := invoke(ctx, s.conn, OpQuery, s.query, func() (driver.Rows, error) {
+		return s.statement.Query(args)
+	})
 	var replacedErr error
 	if err != nil {
-		replacedErr = s.queryErrReplacer(err)
+		replacedErr = s.conn.replaceErr(ctx, err)
 	}
-	s.logHandler.QueryPreparedStatement(s.connCtx, s.query, driverValuesToNamed(args), replacedErr, err, time.Since(t0))
+	dt := time.Since(t0)
+	s.logHandler.QueryPreparedStatement(ctx, s.query, driverValuesToNamed(args), replacedErr, err, dt)
 
 	if err != nil {
+		release()
 		if replacedErr != nil {
 			return nil, replacedErr
 		}
 		return nil, err
 	}
 
-	return rows, nil
+	s.conn.explainer.observe(ctx, s.query, driverValuesToNamed(args), dt)
+
+	return &queryRows{
+		logHandler: s.logHandler,
+		connCtx:    ctx,
+		cancel:     release,
+		rows:       rows,
+	}, nil
 }
 
 func (s *queryStatement) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	if err := s.conn.ensureSession(ctx); err != nil {
+		return nil, err
+	}
+	ctx = s.conn.txContext(ctx)
+
 	stExecerCtx, ok := s.statement.(driver.StmtExecContext)
 	if !ok {
 		return s.Exec(driverNamedToValues(args))
 	}
 
+	ctx, op := s.conn.registry.track(ctx, ActiveExec, s.conn, s.query, args)
+	defer op.done()
+
 	t0 := time.Now()
 
-	result, err := stExecerCtx.ExecContext(ctx, args)
+	tctx, cancel, timeout := s.conn.timeouts.withTimeout(ctx, OpExec)
+	defer cancel()
+
+	result, release, err := invoke(tctx, s.conn, OpExec, s.query, func() (driver.Result, error) {
+		return stExecerCtx.ExecContext(tctx, args)
+	})
+	release()
+	err = checkTimeout(ctx, tctx, s.logHandler, OpExec, s.query, timeout, err)
 	var replacedErr error
 	if err != nil {
-		replacedErr = s.queryErrReplacer(err)
+		replacedErr = s.conn.replaceErr(ctx, err)
 	}
 	s.logHandler.ExecPreparedStatement(ctx, s.query, args, replacedErr, err, time.Since(t0))
 
@@ -92,6 +143,8 @@ func (s *queryStatement) ExecContext(ctx context.Context, args []driver.NamedVal
 		return nil, err
 	}
 
+	logExecResult(ctx, s.logHandler, s.query, args, result)
+
 	return &queryResult{
 		ctx:    ctx,
 		result: result,
@@ -99,31 +152,59 @@ func (s *queryStatement) ExecContext(ctx context.Context, args []driver.NamedVal
 }
 
 func (s *queryStatement) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	if err := s.conn.ensureSession(ctx); err != nil {
+		return nil, err
+	}
+	ctx = s.conn.txContext(ctx)
+
 	stQueryerCtx, ok := s.statement.(driver.StmtQueryContext)
 	if !ok {
 		return s.Query(driverNamedToValues(args))
 	}
 
+	ctx, op := s.conn.registry.track(ctx, ActiveQuery, s.conn, s.query, args)
+
 	t0 := time.Now()
 
-	rows, err := stQueryerCtx.QueryContext(ctx, args)
+	// Query timeout covers iteration over rows, so context is canceled when rows are closed
+	tctx, cancel, timeout := s.conn.timeouts.withTimeout(ctx, OpQuery)
+
+	rows, release, err := invoke(tctx, s.conn, OpQuery, s.query, func() (driver.Rows, error) {
+		return stQueryerCtx.QueryContext(tctx, args)
+	})
+	err = checkTimeout(ctx, tctx, s.logHandler, OpQuery, s.query, timeout, err)
 	var replacedErr error
 	if err != nil {
-		replacedErr = s.queryErrReplacer(err)
+		replacedErr = s.conn.replaceErr(ctx, err)
 	}
-	s.logHandler.QueryPreparedStatement(ctx, s.query, args, replacedErr, err, time.Since(t0))
+	dt := time.Since(t0)
+	s.logHandler.QueryPreparedStatement(ctx, s.query, args, replacedErr, err, dt)
 
 	if err != nil {
+		cancel()
+		release()
+		op.done()
 		if replacedErr != nil {
 			return nil, replacedErr
 		}
 		return nil, err
 	}
 
+	op.setKind(ActiveRows)
+	s.conn.explainer.observe(ctx, s.query, args, dt)
+
 	return &queryRows{
 		logHandler: s.logHandler,
 		connCtx:    ctx,
-		rows:       rows,
+		cancel: func() {
+			cancel()
+			release()
+			op.done()
+		},
+		rows:    rows,
+		query:   s.query,
+		tctx:    tctx,
+		timeout: timeout,
 	}, nil
 }
 
@@ -133,7 +214,12 @@ func (s *queryStatement) CheckNamedValue(value *driver.NamedValue) error {
 		return driver.ErrSkip
 	}
 
-	return connValueChecker.CheckNamedValue(value)
+	err := connValueChecker.CheckNamedValue(value)
+	if sl, ok := s.logHandler.(SessionLogger); ok {
+		sl.CheckNamedValue(s.connCtx, *value, err)
+	}
+
+	return err
 }
 
 func (s *queryStatement) ColumnConverter(idx int) driver.ValueConverter {
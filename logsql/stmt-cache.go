@@ -0,0 +1,241 @@
+package logsql
+
+import (
+	"container/list"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	// invalidStmtMessages are parts of error messages of drivers meaning that prepared statement must be prepared
+	// again, e.g. because schema has changed
+	invalidStmtMessages = []string{"cached plan must not change result type", "prepared statement needs to be re-prepared"}
+)
+
+type (
+	// StmtCacheConfig enables transparent caching of prepared statements per connection. Exec and Query calls of
+	// connection prepare query once and reuse the statement afterwards. Up to Size least recently used statements
+	// are kept per connection, evicted statements are closed once they are not used by open rows. Statements that
+	// returned error meaning they are invalid (see invalidatesStmt) are evicted as well. Zero Size is replaced by 100
+	StmtCacheConfig struct {
+		Size int
+	}
+
+	// StmtCacheStats are cumulative statistics of statement cache of a connection
+	StmtCacheStats struct {
+		Hits      uint64
+		Misses    uint64
+		Evictions uint64
+		Len       int
+	}
+
+	// StmtCacheLogger can be optionally implemented by Logger to log lookups in statement cache
+	StmtCacheLogger interface {
+		StmtCacheLookup(ctx context.Context, query string, hit bool, stats StmtCacheStats)
+	}
+
+	stmtCache struct {
+		size    int
+		entries map[string]*list.Element
+		lru     *list.List
+		stats   StmtCacheStats
+	}
+
+	stmtCacheEntry struct {
+		query   string
+		stmt    driver.Stmt
+		refs    int
+		evicted bool
+	}
+)
+
+func (c StmtCacheConfig) withDefaults() StmtCacheConfig {
+	if c.Size <= 0 {
+		c.Size = 100
+	}
+
+	return c
+}
+
+func newStmtCache(cfg *StmtCacheConfig) *stmtCache {
+	if cfg == nil {
+		return nil
+	}
+
+	return &stmtCache{
+		size:    cfg.Size,
+		entries: make(map[string]*list.Element, cfg.Size),
+		lru:     list.New(),
+	}
+}
+
+// cachedStmt returns entry of cached statement preparing it if necessary. Entry must be released after use
+func (c *connection) cachedStmt(ctx context.Context, query string) (*stmtCacheEntry, error) {
+	cache := c.stmtCache
+
+	elem, hit := cache.entries[query]
+	if hit {
+		cache.stats.Hits++
+		cache.lru.MoveToFront(elem)
+	} else {
+		cache.stats.Misses++
+	}
+
+	if sl, ok := c.logHandler.(StmtCacheLogger); ok {
+		stats := cache.stats
+		stats.Len = cache.lru.Len()
+		sl.StmtCacheLookup(ctx, query, hit, stats)
+	}
+
+	if hit {
+		entry := elem.Value.(*stmtCacheEntry)
+		entry.refs++
+		return entry, nil
+	}
+
+	t0 := time.Now()
+
+	var stmt driver.Stmt
+	var err error
+	if connPrepareCtx, ok := c.conn.(driver.ConnPrepareContext); ok {
+		stmt, err = connPrepareCtx.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.conn.Prepare(query)
+	}
+	c.logHandler.PrepareStatement(ctx, query, err, time.Since(t0))
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &stmtCacheEntry{
+		query: query,
+		stmt:  stmt,
+		refs:  1,
+	}
+	cache.entries[query] = cache.lru.PushFront(entry)
+
+	for cache.lru.Len() > cache.size {
+		evicted := cache.lru.Back().Value.(*stmtCacheEntry)
+		cache.evict(evicted)
+		c.closeCachedStmt(ctx, evicted)
+	}
+
+	return entry, nil
+}
+
+// evict removes entry from cache, its statement is closed once it is not used anymore
+func (c *stmtCache) evict(entry *stmtCacheEntry) {
+	if entry.evicted {
+		return
+	}
+
+	c.lru.Remove(c.entries[entry.query])
+	delete(c.entries, entry.query)
+	c.stats.Evictions++
+	entry.evicted = true
+}
+
+// releaseCachedStmt releases entry closing statement if it was evicted and is not used anymore
+func (c *connection) releaseCachedStmt(ctx context.Context, entry *stmtCacheEntry) {
+	entry.refs--
+	c.closeCachedStmt(ctx, entry)
+}
+
+func (c *connection) closeCachedStmt(ctx context.Context, entry *stmtCacheEntry) {
+	if !entry.evicted || entry.refs > 0 {
+		return
+	}
+
+	t0 := time.Now()
+
+	err := entry.stmt.Close()
+	c.logHandler.ClosePreparedStatement(ctx, entry.query, err, time.Since(t0))
+}
+
+// closeStmtCache evicts all cached statements
+func (c *connection) closeStmtCache() {
+	if c.stmtCache == nil {
+		return
+	}
+
+	for _, elem := range c.stmtCache.entries {
+		entry := elem.Value.(*stmtCacheEntry)
+		entry.evicted = true
+		c.closeCachedStmt(context.Background(), entry)
+	}
+
+	c.stmtCache.entries = map[string]*list.Element{}
+	c.stmtCache.lru.Init()
+}
+
+func (c *connection) execCached(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	entry, err := c.cachedStmt(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer c.releaseCachedStmt(ctx, entry)
+
+	var result driver.Result
+	if stExecerCtx, ok := entry.stmt.(driver.StmtExecContext); ok {
+		result, err = stExecerCtx.ExecContext(ctx, args)
+	} else {
+		result, err = entry.stmt.Exec(driverNamedToValues(args))
+	}
+	if invalidatesStmt(err) {
+		c.stmtCache.evict(entry)
+	}
+
+	return result, err
+}
+
+// queryCached returns rows and function that releases cached statement after rows are closed
+func (c *connection) queryCached(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, func(), error) {
+	entry, err := c.cachedStmt(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows driver.Rows
+	if stQueryerCtx, ok := entry.stmt.(driver.StmtQueryContext); ok {
+		rows, err = stQueryerCtx.QueryContext(ctx, args)
+	} else {
+		rows, err = entry.stmt.Query(driverNamedToValues(args))
+	}
+	if err != nil {
+		if invalidatesStmt(err) {
+			c.stmtCache.evict(entry)
+		}
+		c.releaseCachedStmt(ctx, entry)
+		return nil, nil, err
+	}
+
+	return rows, func() { c.releaseCachedStmt(ctx, entry) }, nil
+}
+
+// invalidatesStmt reports whether statement that returned err must be prepared again: connection is broken,
+// statement does not exist anymore (SQLSTATE 26000) or its plan is invalidated by schema change
+func invalidatesStmt(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+
+	var sqlStateErr interface{ SQLState() string }
+	if errors.As(err, &sqlStateErr) && sqlStateErr.SQLState() == "26000" {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, m := range invalidStmtMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+
+	return false
+}
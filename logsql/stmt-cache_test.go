@@ -0,0 +1,84 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+func TestStmtCacheEvictsInvalidStatements(t *testing.T) {
+	calls := map[string]func(conn *sql.Conn) error{
+		"Exec": func(conn *sql.Conn) error {
+			_, err := conn.ExecContext(context.Background(), "UPDATE t SET a = 1")
+			return err
+		},
+		"Query": func(conn *sql.Conn) error {
+			rows, err := conn.QueryContext(context.Background(), "SELECT a FROM t")
+			if err != nil {
+				return err
+			}
+			return rows.Close()
+		},
+	}
+
+	errs := []struct {
+		err     error
+		invalid bool
+	}{
+		{err: errors.New("ERROR: cached plan must not change result type (SQLSTATE 0A000)"), invalid: true},
+		{err: errors.New("Error 1615: Prepared statement needs to be re-prepared"), invalid: true},
+		{err: sqlStateError("26000"), invalid: true},
+		{err: sqlStateError("23505"), invalid: false},
+		{err: context.DeadlineExceeded, invalid: false},
+	}
+
+	for name, call := range calls {
+		for _, tt := range errs {
+			t.Run(name+" "+tt.err.Error(), func(t *testing.T) {
+				d := fakedriver.New(fakedriver.Config{
+					Features: fakedriver.FeatureAll &^ fakedriver.FeatureExecerContext &^ fakedriver.FeatureExecer &^
+						fakedriver.FeatureQueryerContext &^ fakedriver.FeatureQueryer,
+					Rules: []fakedriver.Rule{{Times: 1, Response: fakedriver.Response{Err: tt.err}}},
+				})
+
+				l := &countingLogger{events: make(map[string]int)}
+				db := sql.OpenDB(NewConnectorFromConnector(d, Config{LogHandler: l, StmtCache: &StmtCacheConfig{}}))
+				defer db.Close()
+				db.SetMaxOpenConns(1)
+
+				conn, err := db.Conn(context.Background())
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				defer conn.Close()
+
+				if err = call(conn); !errors.Is(err, tt.err) {
+					t.Fatalf("expected error, got %v", err)
+				}
+				closes := 0
+				if tt.invalid {
+					closes = 1
+				}
+				if events := l.take(); events["PrepareStatement"] != 1 || events["ClosePreparedStatement"] != closes {
+					t.Errorf("expected %d closes of failed statement, got %v", closes, events)
+				}
+
+				for range 2 {
+					if err = call(conn); err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+				}
+				prepares := 0
+				if tt.invalid {
+					prepares = 1
+				}
+				if events := l.take(); events["PrepareStatement"] != prepares || events["ClosePreparedStatement"] != 0 {
+					t.Errorf("expected statement to be prepared again %d times, got %v", prepares, events)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package logsql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// errDefaultTimeout is a cause of context canceled by default timeout
+	errDefaultTimeout = fmt.Errorf("default timeout: %w", context.DeadlineExceeded)
+)
+
+type (
+	// Timeouts are applied by logged connector to operations which context has no deadline, zero value means no
+	// timeout. Query timeout covers both query and iteration over its rows.
+	//
+	// Connect timeout is applied only by NewConnectorFromConnector, since [driver.Driver] Open has no context.
+	// Begin and Commit timeouts cancel context passed to BeginTx of the driver, so Commit timeout is effective only
+	// for drivers that abort transaction when that context is done. Timeouts of other operations are effective only
+	// for drivers that implement context-aware optional interfaces
+	Timeouts struct {
+		Connect time.Duration
+		Prepare time.Duration
+		Begin   time.Duration
+		Commit  time.Duration
+		Exec    time.Duration
+		Query   time.Duration
+		Ping    time.Duration
+	}
+
+	// TimeoutError is returned instead of driver error if operation failed because of default timeout from Timeouts
+	TimeoutError struct {
+		Op      Op
+		Timeout time.Duration
+		Err     error
+	}
+
+	// TimeoutLogger can be optionally implemented by Logger to log operations that exceeded default timeout.
+	// query is empty for operations not related to a query
+	TimeoutLogger interface {
+		Timeout(ctx context.Context, op Op, query string, timeout time.Duration, err error)
+	}
+)
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("%s exceeded default timeout %s: %s", e.Op, e.Timeout, e.Err)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Is makes TimeoutError match [context.DeadlineExceeded] even if driver returned its own error
+func (e *TimeoutError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+func (t Timeouts) get(op Op) time.Duration {
+	switch op {
+	case OpConnect:
+		return t.Connect
+	case OpPrepare:
+		return t.Prepare
+	case OpBegin:
+		return t.Begin
+	case OpCommit:
+		return t.Commit
+	case OpExec:
+		return t.Exec
+	case OpQuery:
+		return t.Query
+	case OpPing:
+		return t.Ping
+	default:
+		return 0
+	}
+}
+
+// forContext returns default timeout of op if ctx has no deadline, zero otherwise
+func (t Timeouts) forContext(ctx context.Context, op Op) time.Duration {
+	if _, ok := ctx.Deadline(); ok {
+		return 0
+	}
+
+	return t.get(op)
+}
+
+// withTimeout returns context with default timeout of op if ctx has no deadline. Returned timeout is zero if ctx
+// was not changed
+func (t Timeouts) withTimeout(ctx context.Context, op Op) (context.Context, context.CancelFunc, time.Duration) {
+	timeout := t.forContext(ctx, op)
+	if timeout <= 0 {
+		return ctx, func() {}, 0
+	}
+
+	tctx, cancel := context.WithTimeoutCause(ctx, timeout, errDefaultTimeout)
+	return tctx, cancel, timeout
+}
+
+// cancelAfter cancels context with [context.DeadlineExceeded] cause after timeout. Returned function stops the timer,
+// zero timeout disables it
+func cancelAfter(cancel context.CancelCauseFunc, timeout time.Duration) (stop func() bool) {
+	if timeout <= 0 {
+		return func() bool { return true }
+	}
+
+	timer := time.AfterFunc(timeout, func() {
+		cancel(context.DeadlineExceeded)
+	})
+
+	return timer.Stop
+}
+
+// checkTimeout returns *TimeoutError if err was caused by default timeout of tctx and logs it. Otherwise, err is
+// returned as is
+func checkTimeout(ctx context.Context, tctx context.Context, l Logger, op Op, query string, timeout time.Duration,
+	err error) error {
+	if err == nil || timeout == 0 || !errors.Is(context.Cause(tctx), context.DeadlineExceeded) || ctx.Err() != nil {
+		return err
+	}
+
+	if tl, ok := l.(TimeoutLogger); ok {
+		tl.Timeout(ctx, op, query, timeout, err)
+	}
+
+	return &TimeoutError{
+		Op:      op,
+		Timeout: timeout,
+		Err:     err,
+	}
+}
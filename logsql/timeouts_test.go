@@ -0,0 +1,158 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+type (
+	// timeoutLogger records operations that exceeded default timeout
+	timeoutLogger struct {
+		*countingLogger
+
+		mu       sync.Mutex
+		timeouts []string
+	}
+)
+
+func (l *timeoutLogger) Timeout(_ context.Context, op Op, query string, _ time.Duration, _ error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.timeouts = append(l.timeouts, op.String()+" "+query)
+}
+
+func (l *timeoutLogger) take() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	timeouts := l.timeouts
+	l.timeouts = nil
+
+	return timeouts
+}
+
+// openTimeoutDB opens database which queries of table slow take 50ms and rows of them take 50ms each
+func openTimeoutDB(t *testing.T, timeouts Timeouts) (*sql.DB, *timeoutLogger) {
+	t.Helper()
+
+	d := fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{
+			{
+				Pattern:  regexp.MustCompile(`FROM slow`),
+				Response: fakedriver.Response{Latency: 50 * time.Millisecond},
+			},
+			{
+				Pattern: regexp.MustCompile(`FROM rows`),
+				Response: fakedriver.Response{
+					Columns:    []string{"a"},
+					Rows:       [][]driver.Value{{int64(1)}, {int64(2)}},
+					RowLatency: 50 * time.Millisecond,
+				},
+			},
+		},
+	})
+
+	l := &timeoutLogger{countingLogger: &countingLogger{events: make(map[string]int)}}
+	db := sql.OpenDB(NewConnectorFromConnector(d, Config{LogHandler: l, Timeouts: timeouts}))
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db, l
+}
+
+// iterate queries db and reads all rows
+func iterate(ctx context.Context, db *sql.DB, query string, prepared bool) error {
+	var rows *sql.Rows
+	var err error
+	if prepared {
+		var stmt *sql.Stmt
+		stmt, err = db.PrepareContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+		rows, err = stmt.QueryContext(ctx)
+	} else {
+		rows, err = db.QueryContext(ctx, query)
+	}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+	}
+
+	return rows.Err()
+}
+
+func TestTimeoutsQuery(t *testing.T) {
+	for _, prepared := range []bool{false, true} {
+		db, l := openTimeoutDB(t, Timeouts{Query: 20 * time.Millisecond})
+
+		var timeoutErr *TimeoutError
+		if err := iterate(context.Background(), db, "SELECT a FROM slow", prepared); !errors.As(err, &timeoutErr) ||
+			timeoutErr.Op != OpQuery {
+			t.Errorf("prepared %t: expected query timeout, got %v", prepared, err)
+		}
+		if timeouts := l.take(); len(timeouts) != 1 || timeouts[0] != "query SELECT a FROM slow" {
+			t.Errorf("prepared %t: expected timeout to be logged once, got %q", prepared, timeouts)
+		}
+	}
+}
+
+func TestTimeoutsRows(t *testing.T) {
+	for _, prepared := range []bool{false, true} {
+		db, l := openTimeoutDB(t, Timeouts{Query: 80 * time.Millisecond})
+
+		var timeoutErr *TimeoutError
+		if err := iterate(context.Background(), db, "SELECT a FROM rows", prepared); !errors.As(err, &timeoutErr) ||
+			timeoutErr.Op != OpQuery || !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("prepared %t: expected query timeout during iteration, got %v", prepared, err)
+		}
+		if timeouts := l.take(); len(timeouts) != 1 || timeouts[0] != "query SELECT a FROM rows" {
+			t.Errorf("prepared %t: expected timeout to be logged once, got %q", prepared, timeouts)
+		}
+	}
+}
+
+func TestTimeoutsCallerDeadline(t *testing.T) {
+	db, l := openTimeoutDB(t, Timeouts{Query: time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 80*time.Millisecond)
+	defer cancel()
+
+	var timeoutErr *TimeoutError
+	if err := iterate(ctx, db, "SELECT a FROM rows", false); !errors.Is(err, context.DeadlineExceeded) ||
+		errors.As(err, &timeoutErr) {
+		t.Errorf("expected deadline of caller, got %v", err)
+	}
+	if timeouts := l.take(); len(timeouts) != 0 {
+		t.Errorf("expected no default timeouts, got %q", timeouts)
+	}
+
+	if err := iterate(context.Background(), db, "SELECT a FROM rows", false); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestTimeoutsExec(t *testing.T) {
+	db, l := openTimeoutDB(t, Timeouts{Exec: 20 * time.Millisecond})
+
+	var timeoutErr *TimeoutError
+	if _, err := db.Exec("DELETE FROM slow"); !errors.As(err, &timeoutErr) || timeoutErr.Op != OpExec {
+		t.Errorf("expected exec timeout, got %v", err)
+	}
+	if timeouts := l.take(); len(timeouts) != 1 || timeouts[0] != "exec DELETE FROM slow" {
+		t.Errorf("expected timeout to be logged once, got %q", timeouts)
+	}
+}
@@ -3,22 +3,41 @@ package logsql
 import (
 	"context"
 	"database/sql/driver"
+	"sync/atomic"
 	"time"
 )
 
+var (
+	_ driver.Tx = (*queryTransaction)(nil)
+)
+
+// txIDs generates process-wide unique IDs of transactions
+var txIDs atomic.Uint64
+
 type (
 	queryTransaction struct {
 		logHandler Logger
 
-		connCtx     context.Context
-		transaction driver.Tx
+		conn          *connection
+		connCtx       context.Context
+		txCtx         context.Context
+		txCancel      context.CancelCauseFunc
+		commitTimeout time.Duration
+		op            *activeOp
+		transaction   driver.Tx
 	}
 )
 
 func (t *queryTransaction) Commit() error {
 	t0 := time.Now()
 
+	stop := cancelAfter(t.txCancel, t.commitTimeout)
 	err := t.transaction.Commit()
+	stop()
+	err = checkTimeout(t.connCtx, t.txCtx, t.logHandler, OpCommit, "", t.commitTimeout, err)
+	t.txCancel(nil)
+	t.op.done()
+	t.conn.inTx, t.conn.txID = false, 0
 	t.logHandler.TxCommit(t.connCtx, err, time.Since(t0))
 
 	return err
@@ -28,7 +47,28 @@ func (t *queryTransaction) Rollback() error {
 	t0 := time.Now()
 
 	err := t.transaction.Rollback()
+	t.txCancel(nil)
+	t.op.done()
+	t.conn.inTx, t.conn.txID = false, 0
+	// Settings applied within transaction are reverted by rollback
+	t.conn.resetSessionState()
 	t.logHandler.TxRollback(t.connCtx, err, time.Since(t0))
 
 	return err
 }
+
+// TxIDFromContext returns ID of transaction which the event belongs to. Contexts passed to Logger events of
+// transaction, including TxBegin, TxCommit and TxRollback, and of queries executed within it carry the ID
+func TxIDFromContext(ctx context.Context) (uint64, bool) {
+	id, ok := ctx.Value(ctxKeyTxID).(uint64)
+	return id, ok
+}
+
+// txContext returns ctx carrying ID of current transaction of connection, ctx itself outside transactions
+func (c *connection) txContext(ctx context.Context) context.Context {
+	if !c.inTx {
+		return ctx
+	}
+
+	return context.WithValue(ctx, ctxKeyTxID, c.txID)
+}
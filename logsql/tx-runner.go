@@ -0,0 +1,214 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	_ DBTX = (*sql.DB)(nil)
+	_ DBTX = (*sql.Conn)(nil)
+	_ DBTX = (*sql.Tx)(nil)
+
+	savepointSeq atomic.Uint64
+)
+
+type (
+	// DBTX is implemented by *sql.DB, *sql.Conn and *sql.Tx
+	DBTX interface {
+		ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	}
+
+	txBeginner interface {
+		BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	}
+
+	// TxRunOptions configures RunInTx.
+	// TxOptions are passed to BeginTx. Whole transaction is retried according to Retry if it fails before Commit
+	// with error that is one of RetryOn (use it for errors returned by QueryErrReplacer) or classified by Retry as
+	// RetrySameConn, e.g. serialization failure or deadlock. Broken connections (RetryNewConn) are not retried.
+	// Failed Commit is retried by the same rules, e.g. serialization failure at COMMIT means that transaction was
+	// rolled back, but not if connection was broken or context is done, since outcome of such Commit is unknown and
+	// transaction may be applied twice.
+	// If Retry is nil, RetryPolicy with default values is used, set MaxAttempts to 1 to disable retries.
+	// Dialect defines SAVEPOINT syntax for nested calls, DialectPostgres is used if it is empty.
+	// Logger receives events if it implements TxRunnerLogger. Note, that savepoint statements are executed via
+	// ExecContext of the transaction, so they are also logged as Exec events by logged connections
+	TxRunOptions struct {
+		TxOptions *sql.TxOptions
+		Retry     *RetryPolicy
+		RetryOn   []error
+		Dialect   Dialect
+		Logger    Logger
+	}
+
+	// TxRunnerLogger can be optionally implemented by Logger to log events of RunInTx
+	TxRunnerLogger interface {
+		// TxRetry is called before the next attempt of transaction. attempt is a number of failed attempt starting
+		// from 1, err is its error
+		TxRetry(ctx context.Context, attempt int, err error, backoff time.Duration)
+		Savepoint(ctx context.Context, name string, err error, dt time.Duration)
+		ReleaseSavepoint(ctx context.Context, name string, err error, dt time.Duration)
+		RollbackToSavepoint(ctx context.Context, name string, err error, dt time.Duration)
+	}
+)
+
+// RunInTx runs fn inside transaction: it begins transaction, commits it if fn returns nil and rolls it back if fn
+// returns error or panics (panic is propagated after rollback). Failed transactions are retried according to opts.
+//
+// If db is *sql.DB or *sql.Conn, new transaction is started. If db is *sql.Tx (nested call inside fn), fn runs
+// inside SAVEPOINT of that transaction: it is released on success and rolled back to on failure, so the outer
+// transaction can continue. Nested calls are never retried, error is returned to the outer call instead. Example:
+//
+//	err := logsql.RunInTx(ctx, db, logsql.TxRunOptions{}, func(tx *sql.Tx) error {
+//		_, err := tx.ExecContext(ctx, `UPDATE accounts SET balance = balance - $1 WHERE id = $2`, amount, from)
+//		if err != nil {
+//			return err
+//		}
+//
+//		// Failure of audit must not abort the transfer
+//		_ = logsql.RunInTx(ctx, tx, logsql.TxRunOptions{}, func(tx *sql.Tx) error {
+//			_, err := tx.ExecContext(ctx, `INSERT INTO audit (op) VALUES ($1)`, "transfer")
+//			return err
+//		})
+//
+//		_, err = tx.ExecContext(ctx, `UPDATE accounts SET balance = balance + $1 WHERE id = $2`, amount, to)
+//		return err
+//	})
+func RunInTx(ctx context.Context, db DBTX, opts TxRunOptions, fn func(tx *sql.Tx) error) error {
+	opts = opts.withDefaults()
+
+	if tx, ok := db.(*sql.Tx); ok {
+		return runInSavepoint(ctx, tx, opts, fn)
+	}
+
+	beginner, ok := db.(txBeginner)
+	if !ok {
+		return ErrUnsupportedDBTX
+	}
+
+	for attempt := 1; ; attempt++ {
+		commitFailed, err := runInTx(ctx, beginner, opts, fn)
+		if err == nil || commitFailed && ambiguousCommitErr(err) || !opts.retryable(err) ||
+			attempt >= opts.Retry.MaxAttempts {
+			return err
+		}
+
+		backoff := opts.Retry.backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+			return err
+		}
+
+		if tl, ok := opts.Logger.(TxRunnerLogger); ok {
+			tl.TxRetry(ctx, attempt, err, backoff)
+		}
+
+		if !sleep(ctx, backoff) {
+			return err
+		}
+	}
+}
+
+func (o TxRunOptions) withDefaults() TxRunOptions {
+	var retry RetryPolicy
+	if o.Retry != nil {
+		retry = *o.Retry
+	}
+	retry = retry.withDefaults()
+	o.Retry = &retry
+
+	if o.Dialect == (Dialect{}) {
+		o.Dialect = DialectPostgres
+	}
+
+	return o
+}
+
+func (o TxRunOptions) retryable(err error) bool {
+	for _, retryErr := range o.RetryOn {
+		if errors.Is(err, retryErr) {
+			return true
+		}
+	}
+
+	return o.Retry.classify(err) == RetrySameConn
+}
+
+// ambiguousCommitErr reports whether err of Commit leaves outcome of transaction unknown
+func ambiguousCommitErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || IsConnectivityFailure(err)
+}
+
+// runInTx runs fn in a new transaction. commitFailed is true if err is returned by Commit
+func runInTx(ctx context.Context, beginner txBeginner, opts TxRunOptions, fn func(tx *sql.Tx) error) (
+	commitFailed bool, err error) {
+	tx, err := beginner.BeginTx(ctx, opts.TxOptions)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	err = fn(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	err = tx.Commit()
+	return err != nil, err
+}
+
+func runInSavepoint(ctx context.Context, tx *sql.Tx, opts TxRunOptions, fn func(tx *sql.Tx) error) error {
+	if opts.Dialect.Savepoint == "" || opts.Dialect.RollbackToSavepoint == "" {
+		return ErrSavepointsUnsupported
+	}
+
+	tl, _ := opts.Logger.(TxRunnerLogger)
+	name := fmt.Sprintf("logsql_sp_%d", savepointSeq.Add(1))
+
+	err := execSavepoint(ctx, tx, opts.Dialect.Savepoint, name, tl, TxRunnerLogger.Savepoint)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = execSavepoint(ctx, tx, opts.Dialect.RollbackToSavepoint, name, tl, TxRunnerLogger.RollbackToSavepoint)
+			panic(p)
+		}
+	}()
+
+	err = fn(tx)
+	if err != nil {
+		rollbackErr := execSavepoint(ctx, tx, opts.Dialect.RollbackToSavepoint, name, tl, TxRunnerLogger.RollbackToSavepoint)
+		return errors.Join(err, rollbackErr)
+	}
+
+	if opts.Dialect.ReleaseSavepoint == "" {
+		return nil
+	}
+
+	return execSavepoint(ctx, tx, opts.Dialect.ReleaseSavepoint, name, tl, TxRunnerLogger.ReleaseSavepoint)
+}
+
+func execSavepoint(ctx context.Context, tx *sql.Tx, format, name string, tl TxRunnerLogger,
+	event func(TxRunnerLogger, context.Context, string, error, time.Duration)) error {
+	t0 := time.Now()
+
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(format, name))
+	if tl != nil {
+		event(tl, ctx, name, err, time.Since(t0))
+	}
+
+	return err
+}
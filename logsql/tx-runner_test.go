@@ -0,0 +1,140 @@
+package logsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+type (
+	sqlStateError string
+)
+
+func (e sqlStateError) Error() string {
+	return "sqlstate " + string(e)
+}
+
+func (e sqlStateError) SQLState() string {
+	return string(e)
+}
+
+func TestRunInTxRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      fakedriver.Config
+		attempts int
+	}{
+		{
+			name: "serialization failure before commit",
+			cfg: fakedriver.Config{Rules: []fakedriver.Rule{
+				{Times: 1, Response: fakedriver.Response{Err: sqlStateError("40001")}},
+			}},
+			attempts: 2,
+		},
+		{
+			name: "broken connection before commit",
+			cfg: fakedriver.Config{Rules: []fakedriver.Rule{
+				{Times: 1, Response: fakedriver.Response{Err: syscall.ECONNRESET}},
+			}},
+			attempts: 1,
+		},
+		{
+			name:     "serialization failure on commit",
+			cfg:      fakedriver.Config{Commit: fakedriver.Outcome{Err: sqlStateError("40001")}},
+			attempts: 3,
+		},
+		{
+			name:     "broken connection on commit",
+			cfg:      fakedriver.Config{Commit: fakedriver.Outcome{Err: syscall.ECONNRESET}},
+			attempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.Features = fakedriver.FeatureAll
+			db := sql.OpenDB(fakedriver.New(tt.cfg))
+			defer db.Close()
+
+			attempts := 0
+			_ = RunInTx(context.Background(), db, TxRunOptions{Retry: &RetryPolicy{InitialBackoff: time.Millisecond}},
+				func(tx *sql.Tx) error {
+					attempts++
+					_, err := tx.Exec("UPDATE accounts SET balance = 0")
+					return err
+				})
+
+			if attempts != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRunInTxRetryOn(t *testing.T) {
+	errConflict := errors.New("conflict")
+
+	db := sql.OpenDB(fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules:    []fakedriver.Rule{{Times: 2, Response: fakedriver.Response{Err: errConflict}}},
+	}))
+	defer db.Close()
+
+	attempts := 0
+	err := RunInTx(context.Background(), db, TxRunOptions{
+		Retry:   &RetryPolicy{InitialBackoff: time.Millisecond},
+		RetryOn: []error{errConflict},
+	}, func(tx *sql.Tx) error {
+		attempts++
+		_, err := tx.Exec("UPDATE accounts SET balance = 0")
+		return err
+	})
+
+	if err != nil || attempts != 3 {
+		t.Errorf("expected success after 3 attempts, got %d: %v", attempts, err)
+	}
+}
+
+func TestRunInTxCommitRetryOn(t *testing.T) {
+	errConflict := errors.New("conflict")
+
+	tests := []struct {
+		name     string
+		err      error
+		attempts int
+	}{
+		{name: "conflict", err: errConflict, attempts: 3},
+		{name: "bad connection", err: driver.ErrBadConn, attempts: 1},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, attempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := sql.OpenDB(fakedriver.New(fakedriver.Config{
+				Features: fakedriver.FeatureAll,
+				Commit:   fakedriver.Outcome{Err: tt.err},
+			}))
+			defer db.Close()
+
+			attempts := 0
+			_ = RunInTx(context.Background(), db, TxRunOptions{
+				Retry:   &RetryPolicy{InitialBackoff: time.Millisecond},
+				RetryOn: []error{errConflict, driver.ErrBadConn, context.DeadlineExceeded},
+			}, func(tx *sql.Tx) error {
+				attempts++
+				_, err := tx.Exec("UPDATE accounts SET balance = 0")
+				return err
+			})
+
+			if attempts != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, attempts)
+			}
+		})
+	}
+}
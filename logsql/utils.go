@@ -1,7 +1,9 @@
 package logsql
 
 import (
+	"context"
 	"database/sql/driver"
+	"time"
 )
 
 func driverValuesToNamed(args []driver.Value) []driver.NamedValue {
@@ -22,3 +24,16 @@ func driverNamedToValues(args []driver.NamedValue) []driver.Value {
 	}
 	return result
 }
+
+// sleep waits dt or until ctx is done. Returns false if ctx is done
+func sleep(ctx context.Context, dt time.Duration) bool {
+	timer := time.NewTimer(dt)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,218 @@
+package scan
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/alsiberij/sqlutils/fakedriver"
+)
+
+type (
+	testUser struct {
+		Id   int64  `scan:"id"`
+		Name string `scan:"name"`
+	}
+
+	testUserValue struct {
+		Name string `scan:"name"`
+	}
+)
+
+func openUsers(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db := sql.OpenDB(fakedriver.New(fakedriver.Config{
+		Features: fakedriver.FeatureAll,
+		Rules: []fakedriver.Rule{
+			{
+				Query: "SELECT id, name FROM users",
+				Response: fakedriver.Response{
+					Columns: []string{"id", "name"},
+					Rows:    [][]driver.Value{{int64(1), "John"}, {int64(2), "Jane"}},
+				},
+			},
+			{
+				Query: "SELECT name FROM users",
+				Response: fakedriver.Response{
+					Columns: []string{"name"},
+					Rows:    [][]driver.Value{{"John"}, {"Jane"}},
+				},
+			},
+			{
+				Query: "SELECT name, id FROM users",
+				Response: fakedriver.Response{
+					Columns: []string{"name", "id"},
+					Rows:    [][]driver.Value{{"John", int64(1)}},
+				},
+			},
+			{
+				Query:    "SELECT id, name FROM empty",
+				Response: fakedriver.Response{Columns: []string{"id", "name"}},
+			},
+			{
+				Query: "SELECT id, email FROM users",
+				Response: fakedriver.Response{
+					Columns: []string{"id", "email"},
+					Rows:    [][]driver.Value{{int64(1), "john@example.com"}},
+				},
+			},
+			{
+				Query: "SELECT id FROM broken",
+				Response: fakedriver.Response{
+					Columns: []string{"id"},
+					Rows:    [][]driver.Value{{int64(1)}},
+					NextErr: errTest,
+				},
+			},
+		},
+	}))
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return db
+}
+
+var errTest = errors.New("test")
+
+func query(t *testing.T, db *sql.DB, q string) *sql.Rows {
+	t.Helper()
+
+	rows, err := db.Query(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = rows.Close()
+	})
+
+	return rows
+}
+
+func TestCollectRows(t *testing.T) {
+	db := openUsers(t)
+
+	names, err := CollectRows(query(t, db, "SELECT name FROM users"), DirectCollectorRowCols[string])
+	if err != nil || !reflect.DeepEqual(names, []string{"John", "Jane"}) {
+		t.Errorf("direct: %v, %v", names, err)
+	}
+
+	expected := []testUser{{1, "John"}, {2, "Jane"}}
+
+	users, err := CollectRows(query(t, db, "SELECT id, name FROM users"), StructTagCollectorRowCols[testUser])
+	if err != nil || !reflect.DeepEqual(users, expected) {
+		t.Errorf("struct tag: %v, %v", users, err)
+	}
+
+	users, err = CollectRows(query(t, db, "SELECT id, name FROM users"), StructPosCollectorRowCols[testUser])
+	if err != nil || !reflect.DeepEqual(users, expected) {
+		t.Errorf("struct pos: %v, %v", users, err)
+	}
+
+	users, err = CollectRows(query(t, db, "SELECT name, id FROM users"), StructTagCollectorRowCols[testUser])
+	if err != nil || !reflect.DeepEqual(users, []testUser{{1, "John"}}) {
+		t.Errorf("struct tag reordered columns: %v, %v", users, err)
+	}
+
+	users, err = CollectRows(query(t, db, "SELECT id, name FROM empty"), StructTagCollectorRowCols[testUser])
+	if err != nil || users == nil || len(users) != 0 {
+		t.Errorf("empty: %v, %v", users, err)
+	}
+}
+
+func TestCollectRowsErrors(t *testing.T) {
+	db := openUsers(t)
+
+	_, err := CollectRows(query(t, db, "SELECT id, email FROM users"), StructTagCollectorRowCols[testUser])
+	if err == nil {
+		t.Error("expected error for column without destination")
+	}
+
+	_, err = CollectRows(query(t, db, "SELECT name FROM users"), StructTagCollectorRowCols[string])
+	if !errors.Is(err, ErrStructRequired) {
+		t.Errorf("expected ErrStructRequired, got %v", err)
+	}
+
+	_, err = CollectRows(query(t, db, "SELECT id FROM broken"), DirectCollectorRowCols[int64])
+	if !errors.Is(err, errTest) {
+		t.Errorf("expected rows error, got %v", err)
+	}
+}
+
+func TestCollectRowsKV(t *testing.T) {
+	db := openUsers(t)
+
+	names, err := CollectRowsKV(query(t, db, "SELECT id, name FROM users"), DirectCollectorRowColsKV[int64, string])
+	if err != nil || !reflect.DeepEqual(names, map[int64]string{1: "John", 2: "Jane"}) {
+		t.Errorf("direct: %v, %v", names, err)
+	}
+
+	users, err := CollectRowsKV(query(t, db, "SELECT id, name FROM users"),
+		StructTagCollectorRowColsKV[int64, testUserValue])
+	if err != nil || !reflect.DeepEqual(users, map[int64]testUserValue{1: {"John"}, 2: {"Jane"}}) {
+		t.Errorf("struct tag: %v, %v", users, err)
+	}
+
+	users, err = CollectRowsKV(query(t, db, "SELECT id, name FROM users"),
+		StructPosCollectorRowColsKV[int64, testUserValue])
+	if err != nil || !reflect.DeepEqual(users, map[int64]testUserValue{1: {"John"}, 2: {"Jane"}}) {
+		t.Errorf("struct pos: %v, %v", users, err)
+	}
+}
+
+func TestCollectRow(t *testing.T) {
+	db := openUsers(t)
+
+	user, ok, err := CollectRow(db.QueryRow("SELECT id, name FROM users"), StructPosCollectorRow[testUser])
+	if err != nil || !ok || user != (testUser{1, "John"}) {
+		t.Errorf("struct pos: %v, %v, %v", user, ok, err)
+	}
+
+	name, ok, err := CollectRow(db.QueryRow("SELECT name FROM users"), DirectCollectorRow[string])
+	if err != nil || !ok || name != "John" {
+		t.Errorf("direct: %v, %v, %v", name, ok, err)
+	}
+
+	user, ok, err = CollectRow(db.QueryRow("SELECT id, name FROM empty"), StructPosCollectorRow[testUser])
+	if err != nil || ok || user != (testUser{}) {
+		t.Errorf("no rows: %v, %v, %v", user, ok, err)
+	}
+
+	_, ok, err = CollectRow(db.QueryRow("SELECT name FROM users"), StructPosCollectorRow[string])
+	if !errors.Is(err, ErrStructRequired) || ok {
+		t.Errorf("expected ErrStructRequired, got %v, %v", ok, err)
+	}
+}
+
+func TestSlice(t *testing.T) {
+	var ints []int
+	if err := NewSlice(&ints).Scan("{1,2,3}"); err != nil || !reflect.DeepEqual(ints, []int{1, 2, 3}) {
+		t.Errorf("ints: %v, %v", ints, err)
+	}
+
+	var ptrs []*int64
+	if err := NewSlice(&ptrs).Scan("{1,NULL}"); err != nil || len(ptrs) != 2 || *ptrs[0] != 1 || ptrs[1] != nil {
+		t.Errorf("pointers: %v, %v", ptrs, err)
+	}
+
+	var strs []string
+	if err := NewSlice(&strs).Scan("{}"); err != nil || len(strs) != 0 {
+		t.Errorf("empty: %v, %v", strs, err)
+	}
+
+	if err := NewSlice(&strs).Scan("1,2"); !errors.Is(err, ErrSrcIsNotArray) {
+		t.Errorf("expected ErrSrcIsNotArray, got %v", err)
+	}
+
+	var chans []chan int
+	if err := NewSlice(&chans).Scan("{1}"); !errors.Is(err, ErrSliceConversionUnsupported) {
+		t.Errorf("expected ErrSliceConversionUnsupported, got %v", err)
+	}
+
+	if err := NewSlice(&ints).Scan("{a}"); err == nil {
+		t.Error("expected parse error")
+	}
+}